@@ -0,0 +1,76 @@
+package geo
+
+import "math"
+
+// An AffineMatrix represents a 2d affine transform of the form
+//
+//	x' = a*x + b*y + c
+//	y' = d*x + e*y + f
+//
+// Builder methods compose with the existing transform so the operations
+// are applied to points in the order the methods are called,
+// ie. NewAffineMatrix().Scale(2, 2).Translate(1, 0) scales and then translates.
+// In matrix terms each call left multiplies, M' = Op * M.
+type AffineMatrix struct {
+	a, b, c float64
+	d, e, f float64
+}
+
+// NewAffineMatrix creates a new identity transform.
+func NewAffineMatrix() *AffineMatrix {
+	return &AffineMatrix{a: 1, e: 1}
+}
+
+// Translate composes a translation by dx, dy onto the transform.
+func (m *AffineMatrix) Translate(dx, dy float64) *AffineMatrix {
+	m.c += dx
+	m.f += dy
+
+	return m
+}
+
+// Scale composes a scaling about the origin onto the transform.
+func (m *AffineMatrix) Scale(sx, sy float64) *AffineMatrix {
+	m.a, m.b, m.c = sx*m.a, sx*m.b, sx*m.c
+	m.d, m.e, m.f = sy*m.d, sy*m.e, sy*m.f
+
+	return m
+}
+
+// Rotate composes a counter-clockwise rotation about the origin,
+// by the given angle in radians, onto the transform.
+func (m *AffineMatrix) Rotate(angle float64) *AffineMatrix {
+	sin, cos := math.Sincos(angle)
+	return m.Multiply(&AffineMatrix{a: cos, b: -sin, d: sin, e: cos})
+}
+
+// Multiply composes the given transform onto this one, so it is applied
+// after the current transform. Modifies the receiver.
+func (m *AffineMatrix) Multiply(n *AffineMatrix) *AffineMatrix {
+	*m = AffineMatrix{
+		a: n.a*m.a + n.b*m.d,
+		b: n.a*m.b + n.b*m.e,
+		c: n.a*m.c + n.b*m.f + n.c,
+		d: n.d*m.a + n.e*m.d,
+		e: n.d*m.b + n.e*m.e,
+		f: n.d*m.c + n.e*m.f + n.f,
+	}
+
+	return m
+}
+
+// Apply transforms the point by the matrix. Modifies the point.
+func (p *Point) Apply(m *AffineMatrix) *Point {
+	p[0], p[1] = m.a*p[0]+m.b*p[1]+m.c, m.d*p[0]+m.e*p[1]+m.f
+	return p
+}
+
+// Apply transforms all the points in the path by the matrix, in a single pass.
+// Modifies the path.
+func (p *Path) Apply(m *AffineMatrix) *Path {
+	for i := range p.points {
+		p.points[i].Apply(m)
+	}
+
+	return p
+}
@@ -0,0 +1,52 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAffineMatrix(t *testing.T) {
+	if p := NewPoint(1, 2).Apply(NewAffineMatrix()); !p.Equals(NewPoint(1, 2)) {
+		t.Errorf("affine, identity expected %v, got %v", NewPoint(1, 2), p)
+	}
+
+	// scale then translate
+	m := NewAffineMatrix().Scale(2, 3).Translate(1, 1)
+	if p := NewPoint(1, 1).Apply(m); !p.Equals(NewPoint(3, 4)) {
+		t.Errorf("affine, scale translate expected %v, got %v", NewPoint(3, 4), p)
+	}
+
+	// translate then scale
+	m = NewAffineMatrix().Translate(1, 1).Scale(2, 3)
+	if p := NewPoint(1, 1).Apply(m); !p.Equals(NewPoint(4, 6)) {
+		t.Errorf("affine, translate scale expected %v, got %v", NewPoint(4, 6), p)
+	}
+
+	m = NewAffineMatrix().Rotate(math.Pi/2).Translate(1, 0)
+	if p := NewPoint(1, 0).Apply(m); p.DistanceFrom(NewPoint(1, 1)) > epsilon {
+		t.Errorf("affine, rotate expected %v, got %v", NewPoint(1, 1), p)
+	}
+
+	m = NewAffineMatrix().Translate(1, 0).Multiply(NewAffineMatrix().Rotate(math.Pi / 2))
+	if p := NewPoint(0, 0).Apply(m); p.DistanceFrom(NewPoint(0, 1)) > epsilon {
+		t.Errorf("affine, multiply expected %v, got %v", NewPoint(0, 1), p)
+	}
+}
+
+func TestPathApply(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(1, 0))
+	p.Push(NewPoint(1, 1))
+
+	p.Apply(NewAffineMatrix().Scale(2, 2).Translate(-1, 5))
+
+	expected := NewPath()
+	expected.Push(NewPoint(-1, 5))
+	expected.Push(NewPoint(1, 5))
+	expected.Push(NewPoint(1, 7))
+
+	if !p.Equals(expected) {
+		t.Errorf("path, apply expected %v, got %v", expected, p)
+	}
+}
@@ -55,6 +55,17 @@ func BenchmarkLineInterpolate(b *testing.B) {
 	}
 }
 
+func BenchmarkLineInterpolateToPool(b *testing.B) {
+	l := geo.NewLine(geo.NewPoint(1, 2), geo.NewPoint(3, 4))
+	pool := geo.NewPointPool()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		pool.Put(l.InterpolateTo(0.5, pool.Get()))
+	}
+}
+
 func BenchmarkLineMidpoint(b *testing.B) {
 	l := geo.NewLine(geo.NewPoint(1, 2), geo.NewPoint(3, 4))
 
@@ -40,6 +40,17 @@ func BenchmarkPathMeasure(b *testing.B) {
 	}
 }
 
+func BenchmarkPathDirectionAt(b *testing.B) {
+	path := testPath1()
+
+	points := path.Length()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		path.DirectionAt(i % points)
+	}
+}
+
 func BenchmarkPathResampleToMorePoints(b *testing.B) {
 	path := testPath1()
 	totalPoints := int(float64(path.Length()) * 1.616)
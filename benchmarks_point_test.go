@@ -89,3 +89,15 @@ func BenchmarkPointClone(b *testing.B) {
 		p.Clone()
 	}
 }
+
+func BenchmarkPointPool(b *testing.B) {
+	pool := geo.NewPointPool()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p := pool.Get()
+		p.SetX(5).SetY(6)
+		pool.Put(p)
+	}
+}
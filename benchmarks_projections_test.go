@@ -0,0 +1,27 @@
+package geo_test
+
+import (
+	"testing"
+
+	geo "."
+)
+
+func BenchmarkMercatorTransform(b *testing.B) {
+	path := testPath1()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		path.Transform(geo.Mercator.Project)
+		path.Transform(geo.Mercator.Inverse)
+	}
+}
+
+func BenchmarkMercatorProjectSlice(b *testing.B) {
+	points := testPath1().Points()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		geo.ProjectSlice(points, geo.Mercator)
+		geo.InverseSlice(points, geo.Mercator)
+	}
+}
@@ -23,12 +23,22 @@ func NewBound(west, east, south, north float64) *Bound {
 // NewBoundFromPoints creates a new bound given two opposite corners.
 // These corners can be either sw/ne or se/nw.
 func NewBoundFromPoints(corner, oppositeCorner *Point) *Bound {
-	b := &Bound{
-		sw: corner.Clone(),
-		ne: corner.Clone(),
+	return NewBound(corner[0], oppositeCorner[0], corner[1], oppositeCorner[1])
+}
+
+// NewBoundFromPointAndRadius creates a new bound extending radiusMeters in all directions
+// from the lng/lat center point, using the same latitude aware scaling as GeoPad.
+// Bounds reaching a pole, or crossing the anti-meridian, are widened to cover all longitudes.
+func NewBoundFromPointAndRadius(center *Point, radiusMeters float64) *Bound {
+	b := NewBound(center.Lng(), center.Lng(), center.Lat(), center.Lat())
+	b.GeoPad(radiusMeters)
+
+	// GeoPad clamps at the anti-meridian, but a Bound can not wrap around it
+	if b.sw.Lng() <= -180.0 || b.ne.Lng() >= 180.0 {
+		b.sw.SetLng(-180.0)
+		b.ne.SetLng(180.0)
 	}
 
-	b.Extend(oppositeCorner)
 	return b
 }
 
@@ -67,6 +77,16 @@ func NewBoundFromGeoHashInt64(hash int64, bits int) *Bound {
 	return NewBound(west, east, south, north)
 }
 
+// NewEmptyBound returns an inverted bound, with a south west corner of +Inf and
+// north east of -Inf, that contains no points. It is Empty and is the identity
+// for Union and Extend, so it can be used to accumulate a bound.
+func NewEmptyBound() *Bound {
+	return &Bound{
+		sw: &Point{math.Inf(1), math.Inf(1)},
+		ne: &Point{math.Inf(-1), math.Inf(-1)},
+	}
+}
+
 func geoHash2ranges(hash string) (float64, float64, float64, float64) {
 	latMin, latMax := -90.0, 90.0
 	lngMin, lngMax := -180.0, 180.0
@@ -128,7 +148,15 @@ func geoHashInt2ranges(hash int64, bits int) (float64, float64, float64, float64
 }
 
 // Extend grows the bound to include the new point.
+// An uninitialized bound, i.e. Bound{}, or the bound from NewEmptyBound is treated
+// as empty and will become the bound around just the point.
 func (b *Bound) Extend(point *Point) *Bound {
+	if b.isZero() {
+		b.sw = point.Clone()
+		b.ne = point.Clone()
+
+		return b
+	}
 
 	// already included, no big deal
 	if b.Contains(point) {
@@ -145,7 +173,20 @@ func (b *Bound) Extend(point *Point) *Bound {
 }
 
 // Union extends this bounds to contain the union of this and the given bounds.
+// Uninitialized bounds, i.e. Bound{}, and the bound from NewEmptyBound are treated as empty
+// so the union with them is a no-op. This allows for accumulating a bound starting from empty.
 func (b *Bound) Union(other *Bound) *Bound {
+	if other.isZero() {
+		return b
+	}
+
+	if b.isZero() {
+		b.sw = other.sw.Clone()
+		b.ne = other.ne.Clone()
+
+		return b
+	}
+
 	b.Extend(other.SouthWest())
 	b.Extend(other.NorthWest())
 	b.Extend(other.SouthEast())
@@ -154,6 +195,103 @@ func (b *Bound) Union(other *Bound) *Bound {
 	return b
 }
 
+// UnionBounds returns a new bound covering all the given bounds.
+// Nil, uninitialized and empty bounds are skipped, see Union.
+// Returns the bound from NewEmptyBound if there are no non-empty bounds.
+func UnionBounds(bounds ...*Bound) *Bound {
+	result := NewEmptyBound()
+	for _, b := range bounds {
+		if b != nil {
+			result.Union(b)
+		}
+	}
+
+	return result
+}
+
+// UnionWrapped extends this lng/lat bound to contain the given bound, like Union,
+// but considers wrapping across the anti-meridian. The given bound is also tried
+// shifted by +-360 degrees longitude and the option with the smallest width is used.
+// A bound that crosses the anti-meridian is represented with an east edge greater
+// than 180, for example [170, 190] for a bound from 170 to -170. The west edge
+// is kept in [-180, 180). Other methods, e.g. Contains, are not aware of this wrapping.
+func (b *Bound) UnionWrapped(other *Bound) *Bound {
+	if other.isZero() {
+		return b
+	}
+
+	if b.isZero() {
+		b.sw = other.sw.Clone()
+		b.ne = other.ne.Clone()
+
+		return b
+	}
+
+	width := math.Inf(1)
+	shift := 0.0
+	for _, s := range []float64{0, 360, -360} {
+		w := math.Max(b.ne[0], other.ne[0]+s) - math.Min(b.sw[0], other.sw[0]+s)
+		if w < width {
+			width = w
+			shift = s
+		}
+	}
+
+	b.sw[0] = math.Min(b.sw[0], other.sw[0]+shift)
+	b.ne[0] = math.Max(b.ne[0], other.ne[0]+shift)
+
+	b.sw[1] = math.Min(b.sw[1], other.sw[1])
+	b.ne[1] = math.Max(b.ne[1], other.ne[1])
+
+	if width >= 360 {
+		b.sw[0] = -180
+		b.ne[0] = 180
+	} else if b.sw[0] < -180 {
+		b.sw[0] += 360
+		b.ne[0] += 360
+	} else if b.sw[0] >= 180 {
+		b.sw[0] -= 360
+		b.ne[0] -= 360
+	}
+
+	return b
+}
+
+// SplitAtAntimeridian returns the bound as one or two bounds within [-180, 180] longitude,
+// for code that assumes the west edge is less than the east. A bound crossing the anti-meridian,
+// as created by UnionWrapped, for example [170, 190], is split into [170, 180] and [-180, -170].
+// Bounds that do not cross it are returned as a single copy.
+func (b *Bound) SplitAtAntimeridian() []*Bound {
+	south, north := b.sw[1], b.ne[1]
+
+	switch {
+	case b.ne[0]-b.sw[0] >= 360:
+		return []*Bound{NewBound(-180, 180, south, north)}
+	case b.ne[0] > 180:
+		return []*Bound{
+			NewBound(b.sw[0], 180, south, north),
+			NewBound(-180, b.ne[0]-360, south, north),
+		}
+	case b.sw[0] < -180:
+		return []*Bound{
+			NewBound(b.sw[0]+360, 180, south, north),
+			NewBound(-180, b.ne[0], south, north),
+		}
+	}
+
+	return []*Bound{b.Clone()}
+}
+
+// isZero returns true for the zero value Bound{} and for the empty bound
+// returned by NewEmptyBound. NewBound(0, 0, 0, 0) is a valid bound around the origin.
+func (b *Bound) isZero() bool {
+	if b.sw == nil || b.ne == nil {
+		return true
+	}
+
+	return math.IsInf(b.sw[0], 1) && math.IsInf(b.ne[0], -1)
+}
+
 // Contains determines if the point is within the bound.
 // Points on the boundary are considered within.
 func (b *Bound) Contains(point *Point) bool {
@@ -169,6 +307,94 @@ func (b *Bound) Contains(point *Point) bool {
 	return true
 }
 
+// Filter returns the points contained in the bound, in the same order.
+// Points on the boundary are included, see Contains.
+func (b *Bound) Filter(points []Point) []Point {
+	var result []Point
+	for i := range points {
+		if b.Contains(&points[i]) {
+			result = append(result, points[i])
+		}
+	}
+
+	return result
+}
+
+// FilterIndices returns the indexes of the points contained in the bound, in ascending order.
+// Useful to also filter slices of data associated with the points.
+func (b *Bound) FilterIndices(points []Point) []int {
+	var result []int
+	for i := range points {
+		if b.Contains(&points[i]) {
+			result = append(result, i)
+		}
+	}
+
+	return result
+}
+
+// DistanceFrom returns the Euclidean distance from the point to the nearest edge
+// of the bound, or 0 if the point is within the bound.
+func (b *Bound) DistanceFrom(point *Point) float64 {
+	return b.nearestPoint(point).DistanceFrom(point)
+}
+
+// GeoDistanceFrom returns the geodesic distance in meters from the point
+// to the nearest point of the bound, or 0 if the point is within the bound.
+// Assumes lng/lat coordinates and does not consider the anti-meridian.
+func (b *Bound) GeoDistanceFrom(point *Point, haversine ...bool) float64 {
+	return b.nearestPoint(point).GeoDistanceFrom(point, haversine...)
+}
+
+// nearestPoint returns the point in the bound nearest the given point,
+// by clamping the point to the bound.
+func (b *Bound) nearestPoint(point *Point) *Point {
+	return &Point{
+		math.Max(b.sw[0], math.Min(b.ne[0], point[0])),
+		math.Max(b.sw[1], math.Min(b.ne[1], point[1])),
+	}
+}
+
+// BinPoints counts the points falling within each cell of the bound divided into
+// a rows by cols grid. The result is indexed as [row][col] with row 0 along the south edge
+// and col 0 along the west edge. Points on the north or east edge are counted in the last cell.
+// Points outside the bound are ignored. Will panic if rows or cols is not positive.
+func BinPoints(points []*Point, bound *Bound, rows, cols int) [][]int {
+	if rows <= 0 || cols <= 0 {
+		panic(fmt.Sprintf("geo: bin points requires a positive grid size, got %d x %d", rows, cols))
+	}
+
+	grid := make([][]int, rows)
+	for i := range grid {
+		grid[i] = make([]int, cols)
+	}
+
+	width := bound.Width()
+	height := bound.Height()
+	for _, p := range points {
+		if !bound.Contains(p) {
+			continue
+		}
+
+		grid[binIndex(p[1]-bound.sw[1], height, rows)][binIndex(p[0]-bound.sw[0], width, cols)]++
+	}
+
+	return grid
+}
+
+func binIndex(offset, size float64, count int) int {
+	if size == 0 {
+		return 0
+	}
+
+	i := int(offset / size * float64(count))
+	if i >= count {
+		return count - 1
+	}
+
+	return i
+}
+
 // Intersects determines if two bounds intersect.
 // Returns true if they are touching.
 func (b *Bound) Intersects(bound *Bound) bool {
@@ -185,15 +411,51 @@ func (b *Bound) Intersects(bound *Bound) bool {
 	return false
 }
 
-// Center returns the center of the bound.
-func (b *Bound) Center() *Point {
+// Center returns the center of the bound. By default, and like other planar methods,
+// the midpoint of the coordinates is returned. If geo is true the bound is assumed to be
+// lng/lat and the latitude is interpolated in Mercator space, giving the center of the bound
+// as shown on a web map. In that case the longitude is also wrapped into [-180, 180) for
+// bounds crossing the anti-meridian, see UnionWrapped.
+func (b *Bound) Center(geo ...bool) *Point {
 	p := &Point{}
 	p.SetX((b.ne.X() + b.sw.X()) / 2.0)
 	p.SetY((b.ne.Y() + b.sw.Y()) / 2.0)
 
+	if len(geo) == 0 || !geo[0] {
+		return p
+	}
+
+	sw, ne := b.sw.Clone(), b.ne.Clone()
+	Mercator.Project(sw)
+	Mercator.Project(ne)
+
+	c := &Point{0, (sw.Y() + ne.Y()) / 2.0}
+	Mercator.Inverse(c)
+	p.SetLat(c.Lat())
+
+	if p.Lng() >= 180 {
+		p.SetLng(p.Lng() - 360)
+	} else if p.Lng() < -180 {
+		p.SetLng(p.Lng() + 360)
+	}
+
 	return p
 }
 
+// Quadrants splits the bound at its center into four new bounds,
+// returned in quadtree order: north west, north east, south west, south east.
+// Neighboring quadrants share the center coordinates exactly so there are no gaps.
+func (b *Bound) Quadrants() [4]*Bound {
+	c := b.Center()
+
+	return [4]*Bound{
+		NewBound(b.sw[0], c[0], c[1], b.ne[1]),
+		NewBound(c[0], b.ne[0], c[1], b.ne[1]),
+		NewBound(b.sw[0], c[0], b.sw[1], c[1]),
+		NewBound(c[0], b.ne[0], b.sw[1], c[1]),
+	}
+}
+
 // Pad expands the bound in all directions by the amount given. The amount must be
 // in the units of the bounds. Technically one can pad with negative value,
 // but no error checking is done.
@@ -208,20 +470,120 @@ func (b *Bound) Pad(amount float64) *Bound {
 }
 
 // GeoPad expands the bound in all directions by the given amount of meters.
-// Only applies if the data is Lng/Lat degrees.
-func (b *Bound) GeoPad(meters float64) *Bound {
-	dy := meters / 111131.75
-	dx := dy / math.Cos(deg2rad(b.ne.Lat()+b.sw.Lat())/2.0)
+// Only applies if the data is Lng/Lat degrees. The result is clamped to valid
+// lng/lat ranges. If the padding reaches a pole, or the required longitude span
+// is larger than the world, the bound is expanded to the full [-180, 180] longitude range.
+// An optional sphere radius, in meters, can be given to use instead of the default earth model.
+func (b *Bound) GeoPad(meters float64, radius ...float64) *Bound {
+	return b.GeoPadXY(meters, meters, radius...)
+}
 
-	b.sw.SetLng(b.sw.Lng() - dx)
-	b.sw.SetLat(b.sw.Lat() - dy)
+// GeoPadXY is the same as GeoPad but expands the longitude extent by lngMeters
+// and the latitude extent by latMeters, for example to make room for a sidebar.
+// The longitude padding is scaled by the latitude of the center of the bound.
+func (b *Bound) GeoPadXY(lngMeters, latMeters float64, radius ...float64) *Bound {
+	metersPerDegree := 111131.75
+	if len(radius) != 0 {
+		metersPerDegree = deg2rad(radius[0])
+	}
+
+	dy := latMeters / metersPerDegree
+	dx := lngMeters / metersPerDegree / math.Cos(deg2rad(b.ne.Lat()+b.sw.Lat())/2.0)
 
-	b.ne.SetLng(b.ne.Lng() + dx)
-	b.ne.SetLat(b.ne.Lat() + dy)
+	south := b.sw.Lat() - dy
+	north := b.ne.Lat() + dy
+
+	west := b.sw.Lng() - dx
+	east := b.ne.Lng() + dx
+
+	// a circle around a pole covers every longitude,
+	// as does one so wide the longitude span would wrap the earth.
+	if south <= -90.0 || north >= 90.0 || east-west >= 360.0 {
+		west = -180.0
+		east = 180.0
+	}
+
+	b.sw.SetLng(math.Max(west, -180.0))
+	b.sw.SetLat(math.Max(south, -90.0))
+
+	b.ne.SetLng(math.Min(east, 180.0))
+	b.ne.SetLat(math.Min(north, 90.0))
 
 	return b
 }
 
+// GeoInset moves each side of the bound by the given amount of meters,
+// positive values grow the bound and negative values shrink it. Only applies if the data
+// is Lng/Lat degrees. The east and west amounts are converted to degrees separately using
+// the scale at the middle latitude of the resulting bound. If the sides would cross,
+// the bound collapses to the middle of the crossing sides. The result is clamped to valid lng/lat ranges.
+func (b *Bound) GeoInset(north, east, south, west float64) *Bound {
+	n := math.Min(b.ne.Lat()+north/111131.75, 90.0)
+	s := math.Max(b.sw.Lat()-south/111131.75, -90.0)
+	if s > n {
+		s = (s + n) / 2
+		n = s
+	}
+
+	// at the poles any longitude change covers the world
+	scale := math.Cos(deg2rad(n+s) / 2.0)
+	lngDegrees := func(meters float64) float64 {
+		if meters == 0 {
+			return 0
+		}
+
+		return meters / 111131.75 / scale
+	}
+
+	e := math.Min(b.ne.Lng()+lngDegrees(east), 180.0)
+	w := math.Max(b.sw.Lng()-lngDegrees(west), -180.0)
+	if w > e {
+		w = (w + e) / 2
+		e = w
+	}
+
+	b.sw.SetLat(s)
+	b.sw.SetLng(w)
+
+	b.ne.SetLat(n)
+	b.ne.SetLng(e)
+
+	return b
+}
+
+// TileCount returns the number of online map tiles needed to cover the lng/lat bound
+// at the given zoom level, without allocating the tiles. If a max zoom is also given,
+// the count is summed over all the zoom levels in [zoom, maxZoom].
+// Useful for estimating the size of a download before fetching the tiles.
+func (b *Bound) TileCount(zoom int, maxZoom ...int) int {
+	last := zoom
+	if len(maxZoom) != 0 {
+		last = maxZoom[0]
+	}
+
+	count := 0
+	for z := zoom; z <= last; z++ {
+		minX, minY, maxX, maxY := b.tileRange(uint64(z))
+		count += int((maxX - minX + 1) * (maxY - minY + 1))
+	}
+
+	return count
+}
+
+// tileRange returns the inclusive range of the map tiles covering
+// the bound at the given zoom level. Tile y values increase going south.
+func (b *Bound) tileRange(zoom uint64) (minX, minY, maxX, maxY uint64) {
+	minX, minY = scalarMercatorProject(b.sw.Lng(), b.ne.Lat(), zoom)
+	maxX, maxY = scalarMercatorProject(b.ne.Lng(), b.sw.Lat(), zoom)
+
+	// the east edge of the world is the start of the next tile
+	if limit := uint64(1)<<zoom - 1; maxX > limit {
+		maxX = limit
+	}
+
+	return
+}
+
 // Height returns just the difference in the point's Y/Latitude.
 func (b *Bound) Height() float64 {
 	return b.ne.Y() - b.sw.Y()
@@ -249,6 +611,52 @@ func (b *Bound) GeoWidth(haversine ...bool) float64 {
 	return A.GeoDistanceFrom(B, yesHaversine(haversine))
 }
 
+// AspectRatio returns the width divided by the height of the bound.
+// Returns 0 for bounds with zero height.
+func (b *Bound) AspectRatio() float64 {
+	if b.Height() == 0 {
+		return 0
+	}
+
+	return b.Width() / b.Height()
+}
+
+// FitToAspect grows the shorter dimension of the bound, about the center,
+// so that the width divided by the height equals the given ratio.
+// The bound is never shrunk, so everything within the original bound remains within.
+// A bound with zero width and height is not changed. Panics if ratio is not positive.
+func (b *Bound) FitToAspect(ratio float64) *Bound {
+	if ratio <= 0 {
+		panic(fmt.Sprintf("geo: aspect ratio must be positive, given %f", ratio))
+	}
+
+	width := b.Width()
+	height := b.Height()
+
+	if width < height*ratio {
+		delta := (height*ratio - width) / 2
+		b.sw.SetX(b.sw.X() - delta)
+		b.ne.SetX(b.ne.X() + delta)
+	} else if width > height*ratio {
+		delta := (width/ratio - height) / 2
+		b.sw.SetY(b.sw.Y() - delta)
+		b.ne.SetY(b.ne.Y() + delta)
+	}
+
+	return b
+}
+
+// GeoAspectRatio returns the approximate geo width divided by geo height of the bound.
+// Only applies if the data is Lng/Lat degrees. Returns 0 for bounds with zero height.
+func (b *Bound) GeoAspectRatio(haversine ...bool) float64 {
+	height := b.GeoHeight()
+	if height == 0 {
+		return 0
+	}
+
+	return b.GeoWidth(haversine...) / height
+}
+
 // SouthWest returns the lower left corner of the bound.
 func (b *Bound) SouthWest() *Point { return b.sw.Clone() }
 
@@ -287,7 +695,8 @@ func (b *Bound) Equals(c *Bound) bool {
 
 // Clone returns a copy of the bound.
 func (b *Bound) Clone() *Bound {
-	return NewBoundFromPoints(b.sw, b.ne)
+	// copied directly so an empty, inverted, bound stays empty
+	return &Bound{sw: b.sw.Clone(), ne: b.ne.Clone()}
 }
 
 // String returns the string respentation of the bound in the form,
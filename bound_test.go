@@ -2,6 +2,7 @@ package geo
 
 import (
 	"math"
+	"reflect"
 	"testing"
 )
 
@@ -31,6 +32,39 @@ func TestBoundNew(t *testing.T) {
 	}
 }
 
+func TestNewBoundFromPointAndRadius(t *testing.T) {
+	center := NewPoint(-122.4167, 37.7833)
+	b := NewBoundFromPointAndRadius(center, 1000)
+
+	if !b.Center().Equals(center) {
+		t.Errorf("bound, from point and radius should be centered, got %v", b.Center())
+	}
+
+	if math.Abs(b.GeoHeight()-2000) > 1.0 {
+		t.Errorf("bound, from point and radius height incorrect, got %f", b.GeoHeight())
+	}
+
+	if math.Abs(b.GeoWidth()-2000) > 5.0 {
+		t.Errorf("bound, from point and radius width incorrect, got %f", b.GeoWidth())
+	}
+
+	// near the poles
+	b = NewBoundFromPointAndRadius(NewPoint(30, 89.5), 100000)
+	if b.NorthEast().Lat() != 90 || b.SouthWest().Lng() != -180 || b.NorthEast().Lng() != 180 {
+		t.Errorf("bound, from point and radius at pole incorrect, got %v", b)
+	}
+
+	// anti-meridian
+	b = NewBoundFromPointAndRadius(NewPoint(179.99, 0), 10000)
+	if b.SouthWest().Lng() != -180 || b.NorthEast().Lng() != 180 {
+		t.Errorf("bound, from point and radius at anti-meridian incorrect, got %v", b)
+	}
+
+	if math.Abs(b.GeoHeight()-20000) > 1.0 {
+		t.Errorf("bound, from point and radius height incorrect, got %f", b.GeoHeight())
+	}
+}
+
 func TestNewBoundFromMapTile(t *testing.T) {
 	bound := NewBoundFromMapTile(7, 8, 9)
 
@@ -85,6 +119,218 @@ func TestBoundUnion(t *testing.T) {
 	}
 }
 
+func TestBoundUnionEmpty(t *testing.T) {
+	b1 := NewBound(1, 2, 3, 4)
+
+	expected := NewBound(1, 2, 3, 4)
+	if b := b1.Clone().Union(NewEmptyBound()); !b.Equals(expected) {
+		t.Errorf("bound, union with empty bound expected %v, got %v", expected, b)
+	}
+
+	if b := NewEmptyBound().Union(b1); !b.Equals(expected) {
+		t.Errorf("bound, union of empty bound expected %v, got %v", expected, b)
+	}
+
+	if b := b1.Clone().Union(&Bound{}); !b.Equals(expected) {
+		t.Errorf("bound, union with uninitialized bound expected %v, got %v", expected, b)
+	}
+
+	b := &Bound{}
+	if b.Union(b1); !b.Equals(expected) {
+		t.Errorf("bound, union of uninitialized bound expected %v, got %v", expected, b)
+	}
+
+	// result should not share memory with the given bound
+	b.Pad(1)
+	if !b1.Equals(expected) {
+		t.Errorf("bound, union should copy the other bound, got %v", b1)
+	}
+
+	// accumulating
+	b = NewEmptyBound()
+	b.Extend(NewPoint(5, 6))
+	b.Extend(NewPoint(7, 8))
+
+	expected = NewBound(5, 7, 6, 8)
+	if !b.Equals(expected) {
+		t.Errorf("bound, extend of empty bound expected %v, got %v", expected, b)
+	}
+
+	// a bound around the origin is not empty
+	b = NewBoundFromPoints(NewPoint(0, 0), NewPoint(0, 0))
+	b.Extend(NewPoint(1, 1))
+
+	expected = NewBound(0, 1, 0, 1)
+	if !b.Equals(expected) {
+		t.Errorf("bound, extend of origin bound expected %v, got %v", expected, b)
+	}
+
+	if b := NewBound(0, 0, 0, 0).Union(NewBound(1, 2, 1, 2)); !b.Equals(NewBound(0, 2, 0, 2)) {
+		t.Errorf("bound, union of origin bound expected %v, got %v", NewBound(0, 2, 0, 2), b)
+	}
+
+	// empty bounds stay empty
+	if b := NewEmptyBound().Clone(); !b.Empty() || !b.Equals(NewEmptyBound()) {
+		t.Errorf("bound, clone of empty bound should be empty, got %v", b)
+	}
+
+	if b := NewEmptyBound().Clone().Union(NewEmptyBound().Clone()); !b.Empty() || b.Contains(NewPoint(0, 0)) {
+		t.Errorf("bound, union of empty bounds should be empty, got %v", b)
+	}
+
+	if b := NewEmptyBound().Clone().Pad(1); !b.Empty() || b.Contains(NewPoint(0, 0)) {
+		t.Errorf("bound, padded empty bound should be empty, got %v", b)
+	}
+
+	if b := NewPath().Bound().Clone(); !b.Empty() {
+		t.Errorf("bound, clone of empty path bound should be empty, got %v", b)
+	}
+
+	if b := UnionBounds().Clone().Union(b1); !b.Equals(b1) {
+		t.Errorf("bound, union with cloned empty bound expected %v, got %v", b1, b)
+	}
+
+	if b := NewBound(1, 2, 1, 2).Union(NewBound(0, 0, 0, 0)); !b.Equals(NewBound(0, 2, 0, 2)) {
+		t.Errorf("bound, union with origin bound expected %v, got %v", NewBound(0, 2, 0, 2), b)
+	}
+}
+
+func TestUnionBounds(t *testing.T) {
+	b1 := NewBound(0, 1, 0, 1)
+	b2 := NewBound(-2, 0, 3, 4)
+
+	expected := NewBound(-2, 1, 0, 4)
+	if b := UnionBounds(b1, NewEmptyBound(), nil, b2, &Bound{}); !b.Equals(expected) {
+		t.Errorf("bound, union bounds expected %v, got %v", expected, b)
+	}
+
+	// inputs should not be modified
+	if !b1.Equals(NewBound(0, 1, 0, 1)) {
+		t.Errorf("bound, union bounds should not modify the inputs, got %v", b1)
+	}
+
+	if b := UnionBounds(); !b.Equals(NewEmptyBound()) {
+		t.Errorf("bound, union of no bounds expected empty bound, got %v", b)
+	}
+
+	expected = NewBound(-2, 0, 0, 4)
+	if b := UnionBounds(NewBound(0, 0, 0, 0), b2); !b.Equals(expected) {
+		t.Errorf("bound, union bounds with origin expected %v, got %v", expected, b)
+	}
+}
+
+func TestBoundTileCount(t *testing.T) {
+	world := NewBound(-180, 180, -85, 85)
+	if c := world.TileCount(0, 0); c != 1 {
+		t.Errorf("bound, tile count of world at zoom 0 expected 1, got %d", c)
+	}
+
+	if c := world.TileCount(0, 3); c != 1+4+16+64 {
+		t.Errorf("bound, tile count of world at zooms 0-3 expected %d, got %d", 1+4+16+64, c)
+	}
+
+	// a single tile should only need itself, and 4 at the next level
+	tile := NewBoundFromMapTile(7, 8, 9)
+	tile.Pad(-1e-9)
+	if c := tile.TileCount(9, 9); c != 1 {
+		t.Errorf("bound, tile count of tile expected 1, got %d", c)
+	}
+
+	if c := tile.TileCount(9, 10); c != 5 {
+		t.Errorf("bound, tile count of tile expected 5, got %d", c)
+	}
+
+	if c := tile.TileCount(10); c != 4 {
+		t.Errorf("bound, tile count of tile at single zoom expected 4, got %d", c)
+	}
+
+	if c := NewBound(-180, 180, -90, 90).TileCount(20); c != 1<<40 {
+		t.Errorf("bound, tile count of world at zoom 20 expected %d, got %d", 1<<40, c)
+	}
+
+	if c := tile.TileCount(10, 9); c != 0 {
+		t.Errorf("bound, tile count of empty zoom range expected 0, got %d", c)
+	}
+}
+
+func TestBoundUnionWrapped(t *testing.T) {
+	// across the anti-meridian
+	b1 := NewBound(170, 179, 0, 10)
+	b2 := NewBound(-179, -170, 5, 15)
+
+	expected := NewBound(170, 190, 0, 15)
+	if b := b1.Clone().UnionWrapped(b2); !b.Equals(expected) {
+		t.Errorf("bound, union wrapped expected %v, got %v", expected, b)
+	}
+
+	if b := b2.Clone().UnionWrapped(b1); !b.Equals(expected) {
+		t.Errorf("bound, union wrapped expected %v, got %v", expected, b)
+	}
+
+	// already wrapped
+	b := NewBound(170, 190, 0, 15).UnionWrapped(NewBound(-175, -160, 0, 1))
+	expected = NewBound(170, 200, 0, 15)
+	if !b.Equals(expected) {
+		t.Errorf("bound, union wrapped expected %v, got %v", expected, b)
+	}
+
+	// same as regular union if shorter
+	b1 = NewBound(-10, 0, 0, 1)
+	b2 = NewBound(10, 20, 0, 1)
+	if b := b1.Clone().UnionWrapped(b2); !b.Equals(b1.Clone().Union(b2)) {
+		t.Errorf("bound, union wrapped expected %v, got %v", b1.Clone().Union(b2), b)
+	}
+
+	// whole world
+	b = NewBound(-180, 0, 0, 1).UnionWrapped(NewBound(0, 180, 0, 1))
+	expected = NewBound(-180, 180, 0, 1)
+	if !b.Equals(expected) {
+		t.Errorf("bound, union wrapped expected %v, got %v", expected, b)
+	}
+
+	// empty bound
+	b1 = NewBound(170, 179, 0, 10)
+	if b := b1.Clone().UnionWrapped(NewEmptyBound()); !b.Equals(b1) {
+		t.Errorf("bound, union wrapped with empty bound expected %v, got %v", b1, b)
+	}
+}
+
+func TestBoundSplitAtAntimeridian(t *testing.T) {
+	type testCase struct {
+		bound    *Bound
+		expected []*Bound
+	}
+
+	tests := []testCase{
+		{NewBound(170, 190, 0, 10), []*Bound{NewBound(170, 180, 0, 10), NewBound(-180, -170, 0, 10)}},
+		{NewBound(-190, -170, 0, 10), []*Bound{NewBound(170, 180, 0, 10), NewBound(-180, -170, 0, 10)}},
+		{NewBound(-10, 10, 0, 10), []*Bound{NewBound(-10, 10, 0, 10)}},
+		{NewBound(-180, 180, 0, 10), []*Bound{NewBound(-180, 180, 0, 10)}},
+		{NewBound(100, 500, 0, 10), []*Bound{NewBound(-180, 180, 0, 10)}},
+	}
+
+	for i, test := range tests {
+		result := test.bound.SplitAtAntimeridian()
+		if len(result) != len(test.expected) {
+			t.Errorf("bound, splitAtAntimeridian %d expected %v, got %v", i, test.expected, result)
+			continue
+		}
+
+		for j := range result {
+			if !result[j].Equals(test.expected[j]) {
+				t.Errorf("bound, splitAtAntimeridian %d expected %v, got %v", i, test.expected, result)
+			}
+		}
+	}
+
+	// should be a copy
+	b := NewBound(1, 2, 3, 4)
+	b.SplitAtAntimeridian()[0].Pad(1)
+	if !b.Equals(NewBound(1, 2, 3, 4)) {
+		t.Errorf("bound, splitAtAntimeridian should return a copy, got %v", b)
+	}
+}
+
 func TestBoundContains(t *testing.T) {
 	var p *Point
 	bound := NewBound(2, -2, 1, -1)
@@ -125,6 +371,107 @@ func TestBoundContains(t *testing.T) {
 	}
 }
 
+func TestBoundFilter(t *testing.T) {
+	b := NewBound(0, 2, 0, 2)
+	points := []Point{{1, 1}, {3, 1}, {2, 2}, {-1, 0}, {0, 0.5}}
+
+	expected := []Point{{1, 1}, {2, 2}, {0, 0.5}}
+	if f := b.Filter(points); !reflect.DeepEqual(f, expected) {
+		t.Errorf("bound, filter expected %v, got %v", expected, f)
+	}
+
+	if f := b.FilterIndices(points); !reflect.DeepEqual(f, []int{0, 2, 4}) {
+		t.Errorf("bound, filterIndices expected [0 2 4], got %v", f)
+	}
+
+	if f := b.Filter([]Point{{5, 5}}); len(f) != 0 {
+		t.Errorf("bound, filter expected no points, got %v", f)
+	}
+
+	if f := b.FilterIndices(nil); len(f) != 0 {
+		t.Errorf("bound, filterIndices expected no indexes, got %v", f)
+	}
+}
+
+func TestBoundDistanceFrom(t *testing.T) {
+	bound := NewBound(0, 2, 0, 1)
+
+	type testCase struct {
+		point    *Point
+		distance float64
+	}
+
+	tests := []testCase{
+		{NewPoint(1, 0.5), 0},
+		{NewPoint(2, 1), 0},
+		{NewPoint(3, 0.5), 1},
+		{NewPoint(1, -2), 2},
+		{NewPoint(-3, 5), 5},
+	}
+
+	for i, test := range tests {
+		if d := bound.DistanceFrom(test.point); math.Abs(d-test.distance) > epsilon {
+			t.Errorf("bound, distanceFrom %d expected %f, got %f", i, test.distance, d)
+		}
+	}
+}
+
+func TestBoundGeoDistanceFrom(t *testing.T) {
+	bound := NewBound(-1, 1, -1, 1)
+
+	if d := bound.GeoDistanceFrom(NewPoint(0.5, 0.5)); d != 0 {
+		t.Errorf("bound, geoDistanceFrom inside should be 0, got %f", d)
+	}
+
+	point := NewPoint(3, 0)
+	expected := NewPoint(1, 0).GeoDistanceFrom(point)
+	if d := bound.GeoDistanceFrom(point); math.Abs(d-expected) > epsilon {
+		t.Errorf("bound, geoDistanceFrom expected %f, got %f", expected, d)
+	}
+
+	point = NewPoint(3, 3)
+	expected = NewPoint(1, 1).GeoDistanceFrom(point, true)
+	if d := bound.GeoDistanceFrom(point, true); math.Abs(d-expected) > epsilon {
+		t.Errorf("bound, geoDistanceFrom expected %f, got %f", expected, d)
+	}
+}
+
+func TestBinPoints(t *testing.T) {
+	bound := NewBound(0, 4, 0, 2)
+	points := []*Point{
+		NewPoint(0.5, 0.5),
+		NewPoint(0.6, 0.2),
+		NewPoint(3.5, 1.5),
+		NewPoint(4, 2),   // north east corner
+		NewPoint(2, 1),   // on cell boundaries
+		NewPoint(5, 1),   // outside
+		NewPoint(-1, -1), // outside
+	}
+
+	expected := [][]int{
+		{2, 0, 0, 0},
+		{0, 0, 1, 2},
+	}
+
+	if grid := BinPoints(points, bound, 2, 4); !reflect.DeepEqual(grid, expected) {
+		t.Errorf("bound, binPoints incorrect, got %v", grid)
+	}
+
+	// zero size bound
+	zero := NewBound(0.5, 0.5, 0.5, 0.5)
+	expected = [][]int{{1, 0}, {0, 0}}
+	if grid := BinPoints(points[:1], zero, 2, 2); !reflect.DeepEqual(grid, expected) {
+		t.Errorf("bound, binPoints zero bound incorrect, got %v", grid)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("bound, binPoints should panic for invalid grid size")
+		}
+	}()
+	BinPoints(points, bound, 0, 2)
+}
+
 func TestBoundIntersects(t *testing.T) {
 	var tester *Bound
 	bound := NewBound(0, 1, 2, 3)
@@ -175,6 +522,69 @@ func TestBoundCenter(t *testing.T) {
 	if c := b.Center(); !c.Equals(p) {
 		t.Errorf("bound, center expected %v, got %v", p, c)
 	}
+
+	if c := b.Center(false); !c.Equals(p) {
+		t.Errorf("bound, center expected %v, got %v", p, c)
+	}
+}
+
+func TestBoundCenterGeo(t *testing.T) {
+	// symmetric about the equator
+	b := NewBound(-10, 10, -60, 60)
+	if c := b.Center(true); math.Abs(c.Lat()) > epsilon || c.Lng() != 0 {
+		t.Errorf("bound, geo center expected [0, 0], got %v", c)
+	}
+
+	// the mercator center is closer to the pole
+	b = NewBound(0, 10, 0, 80)
+	c := b.Center(true)
+	if c.Lat() <= 40 || c.Lng() != 5 {
+		t.Errorf("bound, geo center should be north of the planar center, got %v", c)
+	}
+
+	expected := NewBound(0, 10, 0, 80)
+	expected.sw.Transform(Mercator.Project)
+	expected.ne.Transform(Mercator.Project)
+	expectedCenter := Point{0, (expected.sw[1] + expected.ne[1]) / 2}
+	Mercator.Inverse(&expectedCenter)
+	if math.Abs(c.Lat()-expectedCenter.Lat()) > epsilon {
+		t.Errorf("bound, geo center expected lat %f, got %f", expectedCenter.Lat(), c.Lat())
+	}
+
+	// wrapped across the anti-meridian
+	b = NewBound(170, 200, 0, 10)
+	if c := b.Center(true); c.Lng() != -175 {
+		t.Errorf("bound, geo center expected lng -175, got %v", c)
+	}
+}
+
+func TestBoundQuadrants(t *testing.T) {
+	b := NewBound(0, 4, -2, 2)
+
+	expected := [4]*Bound{
+		NewBound(0, 2, 0, 2),
+		NewBound(2, 4, 0, 2),
+		NewBound(0, 2, -2, 0),
+		NewBound(2, 4, -2, 0),
+	}
+
+	quads := b.Quadrants()
+	for i := range expected {
+		if !quads[i].Equals(expected[i]) {
+			t.Errorf("bound, quadrant %d expected %v, got %v", i, expected[i], quads[i])
+		}
+	}
+
+	// edges meet exactly at the center
+	b = NewBound(0.1, 0.7, 0.3, 1.9)
+	quads = b.Quadrants()
+	if !quads[0].SouthEast().Equals(quads[3].NorthWest()) || !quads[0].SouthEast().Equals(b.Center()) {
+		t.Errorf("bound, quadrants should meet at the center, got %v", quads)
+	}
+
+	if !UnionBounds(quads[:]...).Equals(b) {
+		t.Errorf("bound, quadrants should cover the bound, got %v", quads)
+	}
 }
 
 func TestBoundPad(t *testing.T) {
@@ -213,6 +623,180 @@ func TestBoundGeoPad(t *testing.T) {
 	}
 }
 
+func TestBoundGeoPadXY(t *testing.T) {
+	b1 := NewBoundFromPoints(NewPoint(-122.559, 37.887), NewPoint(-122.521, 37.911))
+	b2 := b1.Clone().GeoPadXY(300, 100)
+
+	if math.Abs(b1.GeoHeight()+200-b2.GeoHeight()) > 1.0 {
+		t.Errorf("bound, geoPadXY height incorrect, expected %v, got %v", b1.GeoHeight()+200, b2.GeoHeight())
+	}
+
+	if math.Abs(b1.GeoWidth()+600-b2.GeoWidth()) > 2.0 {
+		t.Errorf("bound, geoPadXY width incorrect, expected %v, got %v", b1.GeoWidth()+600, b2.GeoWidth())
+	}
+
+	if b := b1.Clone().GeoPadXY(100, 100); !b.Equals(b1.Clone().GeoPad(100)) {
+		t.Errorf("bound, geoPadXY with equal padding should match geoPad, got %v", b)
+	}
+}
+
+func TestBoundGeoPadRadius(t *testing.T) {
+	b := NewBound(0, 0, 0, 0).GeoPad(math.Pi, 2)
+
+	expected := NewBound(-90, 90, -90, 90)
+	if math.Abs(b.SouthWest().Lat()+90) > epsilon || math.Abs(b.NorthEast().Lat()-90) > epsilon {
+		t.Errorf("bound, geoPad with radius expected %v, got %v", expected, b)
+	}
+
+	b1 := NewBound(10, 11, 10, 11)
+	b2 := b1.Clone().GeoPad(100, EarthRadius)
+	if math.Abs(b1.GeoHeight()+200-b2.GeoHeight()) > 1.0 {
+		t.Errorf("bound, geoPad with radius height incorrect, expected %v, got %v", b1.GeoHeight()+200, b2.GeoHeight())
+	}
+}
+
+func TestBoundGeoInset(t *testing.T) {
+	b := NewBound(-122.5, -122.4, 37.7, 37.8)
+	original := b.Clone()
+
+	b.GeoInset(1000, 0, -500, 2000)
+
+	if d := NewPoint(-122.45, 37.8).GeoDistanceFrom(NewPoint(-122.45, b.NorthEast().Lat())); math.Abs(d-1000) > 5 {
+		t.Errorf("bound, geoInset north expected 1000 meters, got %f", d)
+	}
+
+	if d := NewPoint(-122.45, 37.7).GeoDistanceFrom(NewPoint(-122.45, b.SouthWest().Lat())); math.Abs(d-500) > 5 {
+		t.Errorf("bound, geoInset south expected 500 meters, got %f", d)
+	}
+
+	if b.SouthWest().Lat() <= original.SouthWest().Lat() {
+		t.Errorf("bound, geoInset negative should shrink, got %v", b)
+	}
+
+	if b.NorthEast().Lng() != original.NorthEast().Lng() {
+		t.Errorf("bound, geoInset zero should not change the side, got %v", b)
+	}
+
+	lat := b.Center().Lat()
+	if d := NewPoint(-122.5, lat).GeoDistanceFrom(NewPoint(b.SouthWest().Lng(), lat)); math.Abs(d-2000) > 5 {
+		t.Errorf("bound, geoInset west expected 2000 meters, got %f", d)
+	}
+
+	// crossing sides collapse
+	b = NewBound(0, 0.01, 0, 0.01).GeoInset(-2000, 0, 0, 0)
+	if b.Height() != 0 || b.SouthWest().Lat() > 0.01 || b.SouthWest().Lat() < -0.01 {
+		t.Errorf("bound, geoInset crossing sides should collapse, got %v", b)
+	}
+
+	// clamped
+	b = NewBound(170, 179, 80, 89).GeoInset(1000000, 1000000, 0, 0)
+	if b.NorthEast().Lat() != 90 || b.NorthEast().Lng() != 180 {
+		t.Errorf("bound, geoInset should clamp, got %v", b)
+	}
+}
+
+func TestBoundGeoPadPolar(t *testing.T) {
+	tests := []*Bound{
+		NewBoundFromPoints(NewPoint(10, 89), NewPoint(10, 89)),
+		NewBoundFromPoints(NewPoint(-170, -89.5), NewPoint(-160, -89)),
+		NewBoundFromPoints(NewPoint(0, 89.99), NewPoint(1, 89.99)),
+	}
+
+	for i, b := range tests {
+		b.GeoPad(200000)
+
+		if b.SouthWest().Lat() < -90 || b.NorthEast().Lat() > 90 {
+			t.Errorf("bound, geoPad polar latitude out of range for %d, got %v", i, b)
+		}
+
+		if b.SouthWest().Lng() != -180 || b.NorthEast().Lng() != 180 {
+			t.Errorf("bound, geoPad polar should cover all longitudes for %d, got %v", i, b)
+		}
+	}
+
+	// high latitude but not reaching the pole
+	b := NewBoundFromPoints(NewPoint(10, 80), NewPoint(10, 80)).GeoPad(10000)
+	if b.SouthWest().Lng() <= -180 || b.NorthEast().Lng() >= 180 {
+		t.Errorf("bound, geoPad high latitude should not cover all longitudes, got %v", b)
+	}
+
+	if b.SouthWest().Lng() >= 10 || b.NorthEast().Lng() <= 10 {
+		t.Errorf("bound, geoPad high latitude should pad longitude, got %v", b)
+	}
+}
+
+func TestBoundAspectRatio(t *testing.T) {
+	if r := NewBound(0, 4, 0, 2).AspectRatio(); r != 2 {
+		t.Errorf("bound, aspect ratio expected 2, got %f", r)
+	}
+
+	if r := NewBound(0, 1, 0, 4).AspectRatio(); r != 0.25 {
+		t.Errorf("bound, aspect ratio expected 0.25, got %f", r)
+	}
+
+	if r := NewBound(0, 1, 2, 2).AspectRatio(); r != 0 {
+		t.Errorf("bound, aspect ratio of zero height expected 0, got %f", r)
+	}
+}
+
+func TestBoundFitToAspect(t *testing.T) {
+	// too tall, should widen
+	b := NewBound(0, 2, 0, 2).FitToAspect(2)
+	if expected := NewBound(-1, 3, 0, 2); !b.Equals(expected) {
+		t.Errorf("bound, fit to aspect expected %v, got %v", expected, b)
+	}
+
+	// too wide, should heighten
+	b = NewBound(0, 16, 0, 4).FitToAspect(16.0 / 9)
+	if expected := NewBound(0, 16, -2.5, 6.5); !b.Equals(expected) {
+		t.Errorf("bound, fit to aspect expected %v, got %v", expected, b)
+	}
+
+	if r := b.AspectRatio(); math.Abs(r-16.0/9) > epsilon {
+		t.Errorf("bound, fit to aspect ratio incorrect, got %f", r)
+	}
+
+	// already correct
+	b = NewBound(0, 4, 0, 2).FitToAspect(2)
+	if expected := NewBound(0, 4, 0, 2); !b.Equals(expected) {
+		t.Errorf("bound, fit to aspect expected %v, got %v", expected, b)
+	}
+
+	// zero height
+	b = NewBound(0, 4, 1, 1).FitToAspect(2)
+	if expected := NewBound(0, 4, 0, 2); !b.Equals(expected) {
+		t.Errorf("bound, fit to aspect expected %v, got %v", expected, b)
+	}
+
+	// point bound
+	b = NewBound(1, 1, 1, 1).FitToAspect(2)
+	if expected := NewBound(1, 1, 1, 1); !b.Equals(expected) {
+		t.Errorf("bound, fit to aspect expected %v, got %v", expected, b)
+	}
+}
+
+func TestBoundFitToAspectPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("bound, expect fit to aspect to panic if ratio not positive")
+		}
+	}()
+
+	NewBound(0, 1, 0, 1).FitToAspect(0)
+}
+
+func TestBoundGeoAspectRatio(t *testing.T) {
+	// a degree of longitude is half as wide at 60 degrees
+	b := NewBound(0, 1, 59.5, 60.5)
+	if r := b.GeoAspectRatio(); math.Abs(r-0.5) > 0.01 {
+		t.Errorf("bound, geo aspect ratio expected 0.5, got %f", r)
+	}
+
+	if r := NewBound(0, 1, 2, 2).GeoAspectRatio(); r != 0 {
+		t.Errorf("bound, geo aspect ratio of zero height expected 0, got %f", r)
+	}
+}
+
 func TestBoundAccessors(t *testing.T) {
 	bound := NewBound(1, 2, 3, 4)
 
@@ -63,8 +63,71 @@ func NewClusterWithCentroid(centroid *geo.Point, pointers ...Pointer) *Cluster {
 // Merge merges the given point clusters into the current cluster and returns.
 // It mutates the base cluster. Updates the centroid.
 func (c *Cluster) Merge(c2 *Cluster) {
-	c.Centroid = geo.NewLine(c.Centroid, c2.Centroid).Interpolate(1 - float64(len(c.Pointers))/float64(len(c2.Pointers)+len(c.Pointers)))
+	c.merge(c2, nil)
+}
+
+// merge is Merge with the new centroid taken from the pool, if not nil,
+// and the old centroid put back. Only for clusters owned by the clustering.
+func (c *Cluster) merge(c2 *Cluster, pool *geo.PointPool) {
+	percent := 1 - float64(len(c.Pointers))/float64(len(c2.Pointers)+len(c.Pointers))
+
+	if pool == nil {
+		c.Centroid = geo.NewLine(c.Centroid, c2.Centroid).Interpolate(percent)
+	} else {
+		old := c.Centroid
+		c.Centroid = geo.NewLine(c.Centroid, c2.Centroid).InterpolateTo(percent, pool.Get())
+		pool.Put(old)
+	}
+
 	c.Pointers = append(c.Pointers, c2.Pointers...)
+}
+
+// MemberPoints returns the center points of all the pointers in the cluster.
+// The points are not copied so modifying them will modify the pointers' points.
+func (c *Cluster) MemberPoints() []*geo.Point {
+	points := make([]*geo.Point, 0, len(c.Pointers))
+	for _, pointer := range c.Pointers {
+		points = append(points, pointer.CenterPoint())
+	}
+
+	return points
+}
+
+// Bound returns the bound around the center points of all the pointers in the cluster.
+// Useful for zooming into a cluster. An empty cluster returns the bound from geo.NewEmptyBound.
+func (c *Cluster) Bound() *geo.Bound {
+	if len(c.Pointers) == 0 {
+		return geo.NewEmptyBound()
+	}
+
+	first := c.Pointers[0].CenterPoint()
+	bound := geo.NewBoundFromPoints(first, first)
+	for _, pointer := range c.Pointers[1:] {
+		bound.Extend(pointer.CenterPoint())
+	}
+
+	return bound
+}
+
+// WeightedCentroid returns the average of the cluster centroids weighted by
+// the number of pointers in each cluster, the same weighting used by Merge.
+// Returns nil if there are no clusters or they are all empty.
+func WeightedCentroid(clusters []*Cluster) *geo.Point {
+	var (
+		sumX, sumY float64
+		count      int
+	)
+
+	for _, c := range clusters {
+		n := len(c.Pointers)
+		sumX += c.Centroid.X() * float64(n)
+		sumY += c.Centroid.Y() * float64(n)
+		count += n
+	}
+
+	if count == 0 {
+		return nil
+	}
 
-	return
+	return geo.NewPoint(sumX/float64(count), sumY/float64(count))
 }
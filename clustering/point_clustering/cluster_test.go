@@ -86,4 +86,95 @@ func TestGroupMerge(t *testing.T) {
 	if l := len(c1.Pointers); l != 3 {
 		t.Errorf("event not added to list, %d events", l)
 	}
+
+	// pooled merge should give the same result
+	c1 = NewCluster(&event{Location: geo.NewPoint(1, 0)})
+	c1.merge(c2, geo.NewPointPool())
+	if !c1.Centroid.Equals(geo.NewPoint(1.5, 0.5)) {
+		t.Errorf("centroid not adjusted correctly with pool, got %v", c1.Centroid)
+	}
+}
+
+func TestClusterMemberPoints(t *testing.T) {
+	c := NewCluster()
+	if l := len(c.MemberPoints()); l != 0 {
+		t.Errorf("empty cluster should have no points, got %d", l)
+	}
+
+	c = NewCluster(
+		&event{Location: geo.NewPoint(1, 0)},
+		&event{Location: geo.NewPoint(2, 1)},
+	)
+
+	points := c.MemberPoints()
+	if l := len(points); l != 2 {
+		t.Fatalf("incorrect number of points, got %d", l)
+	}
+
+	if !points[0].Equals(geo.NewPoint(1, 0)) || !points[1].Equals(geo.NewPoint(2, 1)) {
+		t.Errorf("incorrect points, got %v", points)
+	}
+}
+
+func TestClusterBound(t *testing.T) {
+	c := NewCluster()
+	if b := c.Bound(); !b.Equals(geo.NewEmptyBound()) {
+		t.Errorf("empty cluster should have empty bound, got %v", b)
+	}
+
+	c = NewCluster(&event{Location: geo.NewPoint(1, 2)})
+	if b := c.Bound(); !b.Equals(geo.NewBound(1, 1, 2, 2)) {
+		t.Errorf("incorrect bound, got %v", b)
+	}
+
+	c = NewCluster(
+		&event{Location: geo.NewPoint(1, 0)},
+		&event{Location: geo.NewPoint(-2, 1)},
+		&event{Location: geo.NewPoint(3, -2)},
+	)
+
+	if b := c.Bound(); !b.Equals(geo.NewBound(-2, 3, -2, 1)) {
+		t.Errorf("incorrect bound, got %v", b)
+	}
+
+	// origin should not be dropped
+	c = NewCluster(
+		&event{Location: geo.NewPoint(0, 0)},
+		&event{Location: geo.NewPoint(1, 1)},
+	)
+
+	if b := c.Bound(); !b.Equals(geo.NewBound(0, 1, 0, 1)) {
+		t.Errorf("incorrect bound with origin, got %v", b)
+	}
+}
+
+func TestWeightedCentroid(t *testing.T) {
+	if p := WeightedCentroid(nil); p != nil {
+		t.Errorf("no clusters should have nil centroid, got %v", p)
+	}
+
+	if p := WeightedCentroid([]*Cluster{NewCluster()}); p != nil {
+		t.Errorf("empty clusters should have nil centroid, got %v", p)
+	}
+
+	c1 := NewCluster(&event{Location: geo.NewPoint(1, 2)})
+	if p := WeightedCentroid([]*Cluster{c1}); !p.Equals(geo.NewPoint(1, 2)) {
+		t.Errorf("single cluster should have its centroid, got %v", p)
+	}
+
+	c2 := NewCluster(
+		&event{Location: geo.NewPoint(4, 0)},
+		&event{Location: geo.NewPoint(4, 2)},
+		&event{Location: geo.NewPoint(4, 4)},
+	)
+
+	if p := WeightedCentroid([]*Cluster{c1, c2, NewCluster()}); !p.Equals(geo.NewPoint(3.25, 2)) {
+		t.Errorf("incorrect weighted centroid, got %v", p)
+	}
+
+	// should match merging the clusters
+	c1.Merge(c2)
+	if p := WeightedCentroid([]*Cluster{c1}); !p.Equals(c1.Centroid) {
+		t.Errorf("weighted centroid should match merge, got %v", p)
+	}
 }
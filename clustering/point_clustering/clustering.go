@@ -2,11 +2,15 @@ package point_clustering
 
 import (
 	"math"
+	"reflect"
 
 	"github.com/paulmach/go.geo"
 	"github.com/paulmach/go.geo/clustering/shared"
 )
 
+// centroidPool recycles the centroids replaced or discarded while merging clusters.
+var centroidPool = geo.NewPointPool()
+
 // Clustering defines parameters for the point clustering algorithm.
 type Clustering struct {
 	Threshold        float64
@@ -75,6 +79,66 @@ func (c *Clustering) cluster(clusters []*Cluster) []*Cluster {
 	return result
 }
 
+// MergeWithinDistance greedily merges the closest two clusters, recomputing the
+// centroid after each merge, until all the cluster centroids are more than
+// distance apart. Uses euclidean distance, in the units of the points.
+// This is useful for decluttering markers or labels.
+func MergeWithinDistance(pointers []Pointer, distance float64) []*Cluster {
+	return New(distance*distance, CentroidSquaredDistance{}).Cluster(pointers)
+}
+
+// ClusterLabels returns, for each of the input pointers, the index of the cluster
+// in clusters that contains it, or -1 if none do. The labels are in the same order
+// as the input so they can be joined back to the original records. Pointers are
+// matched by equality, for example pointers to structs. Pointers that are not comparable,
+// such as structs containing slices, are matched with reflect.DeepEqual using a slower linear scan.
+func ClusterLabels(pointers []Pointer, clusters []*Cluster) []int {
+	indexes := make(map[Pointer][]int, len(pointers))
+	var others []int // indexes of the pointers that can not be map keys
+	for i, p := range pointers {
+		if !comparablePointer(p) {
+			others = append(others, i)
+			continue
+		}
+
+		indexes[p] = append(indexes[p], i)
+	}
+
+	labels := make([]int, len(pointers))
+	for i := range labels {
+		labels[i] = -1
+	}
+
+	for c, cluster := range clusters {
+		for _, p := range cluster.Pointers {
+			if !comparablePointer(p) {
+				for k, i := range others {
+					if reflect.DeepEqual(pointers[i], p) {
+						labels[i] = c
+						others = append(others[:k], others[k+1:]...)
+						break
+					}
+				}
+
+				continue
+			}
+
+			// the same pointer may be in the input more than once
+			if l := indexes[p]; len(l) > 0 {
+				labels[l[0]] = c
+				indexes[p] = l[1:]
+			}
+		}
+	}
+
+	return labels
+}
+
+// comparablePointer checks if the pointer can be compared with == and used as a map key.
+func comparablePointer(p Pointer) bool {
+	return p == nil || reflect.TypeOf(p).Comparable()
+}
+
 // GeoProjectedClustering defines parameters for the clustering algorithm.
 // This clustering will project all the points to a mercator projection (EPSG:3857)
 // and use a euclidean distance function, scaled appropriately.
@@ -208,9 +272,13 @@ func clusterClusters(
 			break
 		}
 
-		// merge these two
-		clusters[lower].Merge(clusters[higher])
+		// merge these two, the clusters are copies so the centroids can be reused
+		clusters[lower].merge(clusters[higher], centroidPool)
+
+		// the distances still read the merged centroid, so only return it
+		// to the pool once nothing references it.
 		s.ResetDistances(lower, higher)
+		centroidPool.Put(clusters[higher].Centroid)
 		clusters[higher] = nil
 
 		removed++
@@ -4,7 +4,7 @@ import (
 	"compress/gzip"
 	"encoding/json"
 	"os"
-
+	"sync"
 	"testing"
 
 	"github.com/paulmach/go.geo"
@@ -117,6 +117,129 @@ func TestGeoProjectedClusteringClusterClusters(t *testing.T) {
 	}
 }
 
+func TestClusteringClusterConcurrent(t *testing.T) {
+	// the centroid pool is shared, run with -race to check merges don't
+	// hand out centroids still in use by another call.
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			var pointers []Pointer
+			for j := 0; j < 100; j++ {
+				pointers = append(pointers, &event{Location: geo.NewPoint(float64(j%10), float64(j/10))})
+			}
+
+			clusters := New(1.5, CentroidDistance{}).Cluster(pointers)
+
+			total := 0
+			for _, c := range clusters {
+				total += len(c.Pointers)
+			}
+			if total != len(pointers) {
+				t.Errorf("missing pointers, got %d", total)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestMergeWithinDistance(t *testing.T) {
+	pointers := []Pointer{
+		&event{Location: geo.NewPoint(0, 0)},
+		&event{Location: geo.NewPoint(1, 0)},
+		&event{Location: geo.NewPoint(10, 0)},
+		&event{Location: geo.NewPoint(11, 1)},
+		&event{Location: geo.NewPoint(30, 0)},
+	}
+
+	clusters := MergeWithinDistance(pointers, 2)
+	if l := len(clusters); l != 3 {
+		t.Fatalf("incorrect number of clusters, got %d", l)
+	}
+
+	total := 0
+	for _, c := range clusters {
+		total += len(c.Pointers)
+	}
+	if total != len(pointers) {
+		t.Errorf("missing pointers, got %d", total)
+	}
+
+	for i := range clusters {
+		for j := i + 1; j < len(clusters); j++ {
+			if d := clusters[i].Centroid.DistanceFrom(clusters[j].Centroid); d < 2 {
+				t.Errorf("centroids should be at least the distance apart, got %f", d)
+			}
+		}
+	}
+
+	// merging causes the centroid to move closer to others
+	pointers = []Pointer{
+		&event{Location: geo.NewPoint(0, 0)},
+		&event{Location: geo.NewPoint(1, 0)},
+		&event{Location: geo.NewPoint(2.4, 0)},
+	}
+
+	if l := len(MergeWithinDistance(pointers, 2)); l != 1 {
+		t.Errorf("expected clusters to be merged again after recomputing, got %d", l)
+	}
+}
+
+func TestClusterLabels(t *testing.T) {
+	shared := &event{Location: geo.NewPoint(30, 0)}
+	pointers := []Pointer{
+		&event{Location: geo.NewPoint(0, 0)},
+		&event{Location: geo.NewPoint(10, 0)},
+		&event{Location: geo.NewPoint(1, 0)},
+		shared,
+		&event{Location: geo.NewPoint(11, 1)},
+		shared,
+	}
+
+	clusters := MergeWithinDistance(pointers, 2)
+	labels := ClusterLabels(pointers, clusters)
+
+	if l := len(labels); l != len(pointers) {
+		t.Fatalf("incorrect number of labels, got %d", l)
+	}
+
+	for i, label := range labels {
+		if label < 0 || label >= len(clusters) {
+			t.Fatalf("invalid label for %d, got %d", i, label)
+		}
+	}
+
+	if labels[0] != labels[2] || labels[1] != labels[4] || labels[3] != labels[5] {
+		t.Errorf("incorrect labels, got %v", labels)
+	}
+
+	if labels[0] == labels[1] || labels[0] == labels[3] || labels[1] == labels[3] {
+		t.Errorf("incorrect labels, got %v", labels)
+	}
+
+	// pointers not in any cluster
+	labels = ClusterLabels(append(pointers, &event{Location: geo.NewPoint(0, 0)}), clusters)
+	if l := labels[len(labels)-1]; l != -1 {
+		t.Errorf("pointer not in a cluster should have -1 label, got %d", l)
+	}
+
+	// pointers that can not be map keys
+	pointers = []Pointer{
+		trail{geo.NewPoint(0, 0), geo.NewPoint(0, 1)},
+		trail{geo.NewPoint(10, 0)},
+		trail{geo.NewPoint(1, 0)},
+	}
+
+	clusters = MergeWithinDistance(pointers, 2)
+	labels = ClusterLabels(pointers, clusters)
+	if labels[0] != labels[2] || labels[0] == labels[1] || labels[1] == -1 {
+		t.Errorf("incorrect labels for non comparable pointers, got %v", labels)
+	}
+}
+
 // > go test -c && ./clustering.test -test.bench=ClusterClusters -test.cpuprofile=cpu.out -test.benchtime=10s
 // > go tool pprof clustering.test cpu.out
 func BenchmarkClusterClusters(b *testing.B) {
@@ -209,3 +332,10 @@ type event struct {
 func (e *event) CenterPoint() *geo.Point {
 	return e.Location
 }
+
+// trail is a Pointer that is not comparable.
+type trail []*geo.Point
+
+func (t trail) CenterPoint() *geo.Point {
+	return t[0]
+}
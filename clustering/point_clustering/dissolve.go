@@ -0,0 +1,19 @@
+package point_clustering
+
+import "github.com/paulmach/go.geo"
+
+// Dissolve returns the convex hull around all the pointers of all the clusters
+// as a closed, counter-clockwise, ring. Useful for drawing a single outline around
+// a clustering result. Collinear points on the hull are not included. If all the points
+// are collinear the ring will go from one end to the other and back.
+// Returns an empty path if there are no pointers. See geo.ConvexHull.
+func Dissolve(clusters []*Cluster) *geo.Path {
+	var points []geo.Point
+	for _, c := range clusters {
+		for _, pointer := range c.Pointers {
+			points = append(points, *pointer.CenterPoint())
+		}
+	}
+
+	return geo.ConvexHull(points)
+}
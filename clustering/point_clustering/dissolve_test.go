@@ -0,0 +1,64 @@
+package point_clustering
+
+import (
+	"testing"
+
+	"github.com/paulmach/go.geo"
+)
+
+func TestDissolve(t *testing.T) {
+	clusters := []*Cluster{
+		NewCluster(
+			&event{Location: geo.NewPoint(0, 0)},
+			&event{Location: geo.NewPoint(1, 1)},
+			&event{Location: geo.NewPoint(2, 0)},
+		),
+		NewCluster(
+			&event{Location: geo.NewPoint(2, 2)},
+			&event{Location: geo.NewPoint(0, 2)},
+			&event{Location: geo.NewPoint(1, 2)}, // collinear on the hull
+		),
+	}
+
+	expected := geo.NewPath()
+	expected.Push(geo.NewPoint(0, 0))
+	expected.Push(geo.NewPoint(2, 0))
+	expected.Push(geo.NewPoint(2, 2))
+	expected.Push(geo.NewPoint(0, 2))
+	expected.Push(geo.NewPoint(0, 0))
+
+	if p := Dissolve(clusters); !p.Equals(expected) {
+		t.Errorf("incorrect hull, got %v", p.Points())
+	}
+
+	// should not modify the cluster points
+	if p := clusters[0].Pointers[1].CenterPoint(); !p.Equals(geo.NewPoint(1, 1)) {
+		t.Errorf("should not modify pointers, got %v", p)
+	}
+}
+
+func TestDissolveDegenerate(t *testing.T) {
+	if l := Dissolve(nil).Length(); l != 0 {
+		t.Errorf("no clusters should be empty, got %d", l)
+	}
+
+	clusters := []*Cluster{NewCluster(&event{Location: geo.NewPoint(1, 2)})}
+	if p := Dissolve(clusters); p.Length() != 2 || !p.GetAt(0).Equals(geo.NewPoint(1, 2)) {
+		t.Errorf("single point should be a closed ring, got %v", p.Points())
+	}
+
+	clusters = []*Cluster{NewCluster(
+		&event{Location: geo.NewPoint(0, 0)},
+		&event{Location: geo.NewPoint(2, 2)},
+		&event{Location: geo.NewPoint(1, 1)},
+	)}
+
+	expected := geo.NewPath()
+	expected.Push(geo.NewPoint(0, 0))
+	expected.Push(geo.NewPoint(2, 2))
+	expected.Push(geo.NewPoint(0, 0))
+
+	if p := Dissolve(clusters); !p.Equals(expected) {
+		t.Errorf("collinear points incorrect, got %v", p.Points())
+	}
+}
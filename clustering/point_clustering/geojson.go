@@ -0,0 +1,56 @@
+package point_clustering
+
+import "encoding/json"
+
+type geoJSONFeature struct {
+	Type       string                 `json:"type"`
+	Geometry   geoJSONPoint           `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+type geoJSONPoint struct {
+	Type        string     `json:"type"`
+	Coordinates [2]float64 `json:"coordinates"`
+}
+
+type geoJSONFeatureCollection struct {
+	Type     string            `json:"type"`
+	Features []*geoJSONFeature `json:"features"`
+}
+
+// ToGeoJSON returns the cluster as a GeoJSON Point feature located at the centroid
+// with `cluster` and `point_count` properties, similar to the output of Supercluster.
+// Clusters with a single pointer are single points, so have a count of 1 and `cluster` false.
+// Returns an error if the centroid can not be encoded, e.g. contains NaN values.
+func (c *Cluster) ToGeoJSON() ([]byte, error) {
+	return json.Marshal(c.geoJSONFeature())
+}
+
+// ClustersToFeatureCollection returns the clusters as a GeoJSON FeatureCollection
+// of Point features, see Cluster.ToGeoJSON. Returns an error if a centroid can not be encoded.
+func ClustersToFeatureCollection(clusters []*Cluster) ([]byte, error) {
+	fc := &geoJSONFeatureCollection{
+		Type:     "FeatureCollection",
+		Features: make([]*geoJSONFeature, 0, len(clusters)),
+	}
+
+	for _, c := range clusters {
+		fc.Features = append(fc.Features, c.geoJSONFeature())
+	}
+
+	return json.Marshal(fc)
+}
+
+func (c *Cluster) geoJSONFeature() *geoJSONFeature {
+	return &geoJSONFeature{
+		Type: "Feature",
+		Geometry: geoJSONPoint{
+			Type:        "Point",
+			Coordinates: c.Centroid.ToArray(),
+		},
+		Properties: map[string]interface{}{
+			"cluster":     len(c.Pointers) > 1,
+			"point_count": len(c.Pointers),
+		},
+	}
+}
@@ -0,0 +1,52 @@
+package point_clustering
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulmach/go.geo"
+)
+
+func TestClusterToGeoJSON(t *testing.T) {
+	c := NewCluster(
+		&event{Location: geo.NewPoint(1, 2)},
+		&event{Location: geo.NewPoint(3, 4)},
+	)
+
+	expected := `{"type":"Feature","geometry":{"type":"Point","coordinates":[2,3]},"properties":{"cluster":true,"point_count":2}}`
+	if data, err := c.ToGeoJSON(); err != nil || string(data) != expected {
+		t.Errorf("incorrect geojson, got %s, %v", data, err)
+	}
+
+	// single points are not clusters
+	c = NewCluster(&event{Location: geo.NewPoint(1, 2)})
+
+	expected = `{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":{"cluster":false,"point_count":1}}`
+	if data, err := c.ToGeoJSON(); err != nil || string(data) != expected {
+		t.Errorf("incorrect geojson, got %s, %v", data, err)
+	}
+
+	c = NewClusterWithCentroid(geo.NewPoint(math.NaN(), 0))
+	if _, err := c.ToGeoJSON(); err == nil {
+		t.Error("expected error for NaN centroid")
+	}
+}
+
+func TestClustersToFeatureCollection(t *testing.T) {
+	clusters := []*Cluster{
+		NewCluster(&event{Location: geo.NewPoint(1, 2)}),
+		NewCluster(&event{Location: geo.NewPoint(5, 6)}, &event{Location: geo.NewPoint(5, 6)}),
+	}
+
+	expected := `{"type":"FeatureCollection","features":[` +
+		`{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":{"cluster":false,"point_count":1}},` +
+		`{"type":"Feature","geometry":{"type":"Point","coordinates":[5,6]},"properties":{"cluster":true,"point_count":2}}]}`
+	if data, err := ClustersToFeatureCollection(clusters); err != nil || string(data) != expected {
+		t.Errorf("incorrect geojson, got %s, %v", data, err)
+	}
+
+	expected = `{"type":"FeatureCollection","features":[]}`
+	if data, err := ClustersToFeatureCollection(nil); err != nil || string(data) != expected {
+		t.Errorf("incorrect geojson, got %s, %v", data, err)
+	}
+}
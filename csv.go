@@ -0,0 +1,95 @@
+package geo
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// ReadPathCSV reads a path from CSV data with one point per row. The first two columns
+// are used, lng,lat if lngFirst is true, lat,lng otherwise, other columns are ignored.
+// The first row is skipped if it is not numeric, i.e. a header row.
+// Returns an error, with the line number, for malformed rows.
+func ReadPathCSV(r io.Reader, lngFirst bool) (*Path, error) {
+	if lngFirst {
+		return ReadCSVPath(r, 0, 1)
+	}
+
+	return ReadCSVPath(r, 1, 0)
+}
+
+// ReadCSVPath reads a path from CSV data with one point per row, taking the lng and lat
+// from the given zero based columns. Other columns are ignored. Like ReadPathCSV, the first row
+// is skipped if those columns are not numeric, i.e. a header row.
+// Returns an error, with the line number, for malformed rows.
+func ReadCSVPath(r io.Reader, lngCol, latCol int) (*Path, error) {
+	if lngCol < 0 || latCol < 0 {
+		return nil, fmt.Errorf("geo: csv columns must not be negative, given %d and %d", lngCol, latCol)
+	}
+
+	columns := lngCol + 1
+	if latCol >= columns {
+		columns = latCol + 1
+	}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	p := NewPath()
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(record) < columns {
+			return nil, fmt.Errorf("geo: csv line %d has %d columns, expected at least %d", line, len(record), columns)
+		}
+
+		lng, errLng := strconv.ParseFloat(record[lngCol], 64)
+		lat, errLat := strconv.ParseFloat(record[latCol], 64)
+
+		if errLng != nil || errLat != nil {
+			if line == 1 {
+				continue // header
+			}
+
+			return nil, fmt.Errorf("geo: csv line %d is not numeric: %v", line, []string{record[lngCol], record[latCol]})
+		}
+
+		p.points = append(p.points, Point{lng, lat})
+	}
+
+	return p, nil
+}
+
+// WritePathCSV writes the path as CSV data with one point per row, without a header.
+// The order is lng,lat if lngFirst is true, lat,lng otherwise. Inverse of ReadPathCSV.
+func WritePathCSV(w io.Writer, p *Path, lngFirst bool) error {
+	writer := csv.NewWriter(w)
+
+	record := make([]string, 2)
+	for _, point := range p.points {
+		x := strconv.FormatFloat(point[0], 'f', -1, 64)
+		y := strconv.FormatFloat(point[1], 'f', -1, 64)
+
+		if lngFirst {
+			record[0], record[1] = x, y
+		} else {
+			record[0], record[1] = y, x
+		}
+
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,123 @@
+package geo
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestReadPathCSV(t *testing.T) {
+	data := "lng,lat\n-122.4167,37.7833\n-122.4157, 37.7843,extra\n"
+
+	p, err := ReadPathCSV(strings.NewReader(data), true)
+	if err != nil {
+		t.Fatalf("csv, read error: %v", err)
+	}
+
+	expected := NewPath()
+	expected.Push(NewPoint(-122.4167, 37.7833))
+	expected.Push(NewPoint(-122.4157, 37.7843))
+
+	if !p.Equals(expected) {
+		t.Errorf("csv, read expected %v, got %v", expected.Points(), p.Points())
+	}
+
+	// lat first without a header
+	p, err = ReadPathCSV(strings.NewReader("37.7833,-122.4167\n37.7843,-122.4157\n"), false)
+	if err != nil {
+		t.Fatalf("csv, read error: %v", err)
+	}
+
+	if !p.Equals(expected) {
+		t.Errorf("csv, read lat first expected %v, got %v", expected.Points(), p.Points())
+	}
+
+	p, err = ReadPathCSV(strings.NewReader(""), true)
+	if err != nil || p.Length() != 0 {
+		t.Errorf("csv, read of empty data should be empty, got %v %v", p, err)
+	}
+}
+
+func TestReadPathCSVErrors(t *testing.T) {
+	tests := map[string]string{
+		"lng,lat\n1,2\n3,x\n": "line 3",
+		"1,2\nlng,lat\n":      "line 2",
+		"1,2\n3\n":            "line 2",
+	}
+
+	for data, expected := range tests {
+		_, err := ReadPathCSV(strings.NewReader(data), true)
+		if err == nil {
+			t.Errorf("csv, expected error for %q", data)
+			continue
+		}
+
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("csv, expected error with %q, got %v", expected, err)
+		}
+	}
+}
+
+func TestReadCSVPath(t *testing.T) {
+	data := "id,name,lat,lng\n1,a,37.7833,-122.4167\n2,b,37.7843,-122.4157\n"
+
+	p, err := ReadCSVPath(strings.NewReader(data), 3, 2)
+	if err != nil {
+		t.Fatalf("csv, read columns error: %v", err)
+	}
+
+	expected := NewPath()
+	expected.Push(NewPoint(-122.4167, 37.7833))
+	expected.Push(NewPoint(-122.4157, 37.7843))
+
+	if !p.Equals(expected) {
+		t.Errorf("csv, read columns expected %v, got %v", expected.Points(), p.Points())
+	}
+
+	tests := map[string]string{
+		"1,a,37.7833,-122.4167\n2,b,x,-122.4157\n": "line 2",
+		"1,a,37.7833,-122.4167\n2,b,37.7843\n":     "line 2",
+	}
+
+	for data, expected := range tests {
+		_, err := ReadCSVPath(strings.NewReader(data), 3, 2)
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("csv, read columns expected error with %q, got %v", expected, err)
+		}
+	}
+
+	if _, err := ReadCSVPath(strings.NewReader(data), -1, 2); err == nil {
+		t.Error("csv, read columns expected error for negative column")
+	}
+}
+
+func TestWritePathCSV(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(-122.4167, 37.7833))
+	p.Push(NewPoint(1, 2))
+
+	buf := &bytes.Buffer{}
+	if err := WritePathCSV(buf, p, false); err != nil {
+		t.Fatalf("csv, write error: %v", err)
+	}
+
+	expected := "37.7833,-122.4167\n2,1\n"
+	if s := buf.String(); s != expected {
+		t.Errorf("csv, write expected %q, got %q", expected, s)
+	}
+
+	// round trip
+	for _, lngFirst := range []bool{true, false} {
+		buf.Reset()
+		WritePathCSV(buf, p, lngFirst)
+
+		p2, err := ReadPathCSV(buf, lngFirst)
+		if err != nil {
+			t.Fatalf("csv, read error: %v", err)
+		}
+
+		if !p2.Equals(p) {
+			t.Errorf("csv, round trip expected %v, got %v", p.Points(), p2.Points())
+		}
+	}
+}
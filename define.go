@@ -1,6 +1,8 @@
 package geo
 
 import (
+	"errors"
+	"fmt"
 	"math"
 )
 
@@ -17,6 +19,22 @@ var EarthRadius = 6378137.0 // meters
 // GeoHashPrecision is the number of charactors of a encoded GeoHash.
 var GeoHashPrecision = 12
 
+// ErrVincentyNoConvergence is returned by the Vincenty distance methods if the
+// iterative formula does not converge, which can happen for nearly antipodal points.
+var ErrVincentyNoConvergence = errors.New("geo: vincenty formula failed to converge")
+
+// An IndexError is the value path methods panic with when given an index
+// out of range. It can be recovered and type asserted to handle the error programmatically.
+type IndexError struct {
+	Op     string // the operation, e.g. "set", "insert" or "remove"
+	Index  int
+	Length int
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("geo: %s index out of range, requested: %d, length: %d", e.Op, e.Index, e.Length)
+}
+
 func yesHaversine(haversine []bool) bool {
 	return (len(haversine) != 0 && haversine[0]) || (UseHaversineGeoDistanceByDefault && len(haversine) == 0)
 }
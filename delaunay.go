@@ -0,0 +1,264 @@
+package geo
+
+// Delaunay computes the Delaunay triangulation of the points using the
+// Bowyer-Watson incremental algorithm. The triangles are returned as indexes
+// into the input slice and are wound counter-clockwise. Duplicate points are
+// skipped, only the first is used, and sets of fewer than 3 distinct points,
+// or only collinear points, return no triangles. Runs in O(n^2) worst case.
+func Delaunay(points []Point) [][3]int {
+	// indexes into the input of the distinct points
+	var indexes []int
+	seen := make(map[Point]bool, len(points))
+	for i, p := range points {
+		if seen[p] {
+			continue
+		}
+
+		seen[p] = true
+		indexes = append(indexes, i)
+	}
+
+	vertices := make([]Point, len(indexes))
+	for i, index := range indexes {
+		vertices[i] = points[index]
+	}
+
+	// start with the first counter-clockwise triangle, if there is one
+	first := -1
+	for i := 2; i < len(vertices); i++ {
+		if cross(&vertices[0], &vertices[1], &vertices[i]) != 0 {
+			first = i
+			break
+		}
+	}
+
+	if first == -1 {
+		return nil
+	}
+
+	a, b := 0, 1
+	if cross(&vertices[0], &vertices[1], &vertices[first]) < 0 {
+		a, b = 1, 0
+	}
+
+	// Instead of a finite super triangle, which can be too small and lose
+	// triangles on the hull, each hull edge has a "ghost" triangle with a
+	// vertex at infinity. Its circumcircle is the half plane outside the edge.
+	ghost := len(vertices)
+	triangles := [][3]int{
+		{a, b, first},
+		{b, a, ghost},
+		{first, b, ghost},
+		{a, first, ghost},
+	}
+
+	for i := range vertices {
+		if i == 0 || i == 1 || i == first {
+			continue
+		}
+		p := &vertices[i]
+
+		// remove the triangles whose circumcircle contains the point,
+		// keeping the edges of the hole they leave.
+		var edges [][2]int
+		kept := triangles[:0]
+		for _, t := range triangles {
+			if !inTriangleCircumcircle(vertices, t, ghost, p) {
+				kept = append(kept, t)
+				continue
+			}
+
+			edges = append(edges, [2]int{t[0], t[1]}, [2]int{t[1], t[2]}, [2]int{t[2], t[0]})
+		}
+		triangles = kept
+
+		// edges shared by two removed triangles are inside the hole,
+		// they appear once in each direction.
+		for _, e := range edges {
+			shared := false
+			for _, o := range edges {
+				if e[0] == o[1] && e[1] == o[0] {
+					shared = true
+					break
+				}
+			}
+
+			if !shared {
+				// the removed triangles are counter-clockwise so this one is too
+				triangles = append(triangles, [3]int{e[0], e[1], i})
+			}
+		}
+	}
+
+	var result [][3]int
+	for _, t := range triangles {
+		if t[0] == ghost || t[1] == ghost || t[2] == ghost {
+			continue
+		}
+
+		// nearly collinear points can leave slivers with no area
+		if cross(&vertices[t[0]], &vertices[t[1]], &vertices[t[2]]) <= 0 {
+			continue
+		}
+
+		result = append(result, [3]int{indexes[t[0]], indexes[t[1]], indexes[t[2]]})
+	}
+
+	return result
+}
+
+// inTriangleCircumcircle returns true if p is inside the circumcircle of the
+// counter-clockwise triangle. For ghost triangles, with the ghost index as a vertex,
+// that is the open half plane outside the hull edge and the inside of the edge itself.
+func inTriangleCircumcircle(vertices []Point, t [3]int, ghost int, p *Point) bool {
+	for i := range t {
+		if t[i] != ghost {
+			continue
+		}
+
+		// the hull is to the right of a->b
+		a, b := &vertices[t[(i+1)%3]], &vertices[t[(i+2)%3]]
+		if c := cross(a, b, p); c != 0 {
+			return c > 0
+		}
+
+		return (p[0]-a[0])*(p[0]-b[0])+(p[1]-a[1])*(p[1]-b[1]) < 0
+	}
+
+	return inCircumcircle(&vertices[t[0]], &vertices[t[1]], &vertices[t[2]], p)
+}
+
+// inCircumcircle returns true if p is strictly inside the circumcircle
+// of the counter-clockwise triangle a, b, c.
+func inCircumcircle(a, b, c, p *Point) bool {
+	ax, ay := a[0]-p[0], a[1]-p[1]
+	bx, by := b[0]-p[0], b[1]-p[1]
+	cx, cy := c[0]-p[0], c[1]-p[1]
+
+	det := (ax*ax+ay*ay)*(bx*cy-cx*by) -
+		(bx*bx+by*by)*(ax*cy-cx*ay) +
+		(cx*cx+cy*cy)*(ax*by-bx*ay)
+
+	return det > 0
+}
+
+// Voronoi computes the Voronoi cell of each of the points, clipped to the bound.
+// The cells are built as the dual of the Delaunay triangulation, each cell being the
+// part of the bound closer to its point than to any of the point's Delaunay neighbors.
+// The result is in the same order as the input with closed, counter-clockwise outer rings.
+// Duplicate points get the same cell and cells with no area within the bound are nil.
+// If there is no triangulation, e.g. all the points are collinear, every other point
+// is considered a neighbor.
+func Voronoi(points []Point, clip *Bound) []*Polygon {
+	neighbors := make(map[Point]map[Point]bool, len(points))
+	for _, p := range points {
+		neighbors[p] = make(map[Point]bool)
+	}
+
+	triangles := Delaunay(points)
+	for _, t := range triangles {
+		for i := 0; i < 3; i++ {
+			a, b := points[t[i]], points[t[(i+1)%3]]
+			neighbors[a][b] = true
+			neighbors[b][a] = true
+		}
+	}
+
+	if len(triangles) == 0 {
+		for p := range neighbors {
+			for q := range neighbors {
+				if p != q {
+					neighbors[p][q] = true
+				}
+			}
+		}
+	}
+
+	cells := make(map[Point]*Polygon, len(neighbors))
+	result := make([]*Polygon, len(points))
+	for i, p := range points {
+		if cell, ok := cells[p]; ok {
+			if cell != nil {
+				result[i] = cell.Clone()
+			}
+			continue
+		}
+
+		ring := []Point{
+			*clip.SouthWest(), *clip.SouthEast(),
+			*clip.NorthEast(), *clip.NorthWest(),
+		}
+
+		// sorted so the result does not depend on map order
+		others := make([]Point, 0, len(neighbors[p]))
+		for q := range neighbors[p] {
+			others = append(others, q)
+		}
+		SortPoints(others)
+
+		for _, q := range others {
+			ring = clipToHalfPlane(ring, &p, &q)
+			if len(ring) < 3 {
+				break
+			}
+		}
+
+		var cell *Polygon
+		if len(ring) >= 3 {
+			outer := NewPathPreallocate(0, len(ring)+1)
+			outer.points = append(outer.points, ring...)
+			outer.points = append(outer.points, ring[0])
+
+			cell = NewPolygon(outer)
+			result[i] = cell
+		}
+
+		cells[p] = cell
+	}
+
+	return result
+}
+
+// clipToHalfPlane clips the convex ring to the points closer to p than to q.
+// The ring is not closed, i.e. the first point is not repeated at the end.
+func clipToHalfPlane(ring []Point, p, q *Point) []Point {
+	// points x with (x - m) . n <= 0 are on p's side
+	n := Point{q[0] - p[0], q[1] - p[1]}
+	m := Point{(p[0] + q[0]) / 2, (p[1] + q[1]) / 2}
+	side := func(x *Point) float64 {
+		return (x[0]-m[0])*n[0] + (x[1]-m[1])*n[1]
+	}
+
+	result := make([]Point, 0, len(ring)+1)
+	for i := range ring {
+		a, b := &ring[i], &ring[(i+1)%len(ring)]
+		sa, sb := side(a), side(b)
+
+		if sa <= 0 {
+			result = append(result, *a)
+		}
+
+		if (sa < 0 && sb > 0) || (sa > 0 && sb < 0) {
+			t := sa / (sa - sb)
+			result = append(result, Point{a[0] + t*(b[0]-a[0]), a[1] + t*(b[1]-a[1])})
+		}
+	}
+
+	// remove slivers left by points that only touch the half plane
+	if len(result) >= 3 && ringArea(result) == 0 {
+		return nil
+	}
+
+	return result
+}
+
+// ringArea returns the signed area of the unclosed ring, positive if counter-clockwise.
+func ringArea(ring []Point) float64 {
+	area := 0.0
+	for i := range ring {
+		a, b := &ring[i], &ring[(i+1)%len(ring)]
+		area += a[0]*b[1] - b[0]*a[1]
+	}
+
+	return area / 2
+}
@@ -0,0 +1,219 @@
+package geo
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestDelaunay(t *testing.T) {
+	square := []Point{{0, 0}, {1, 0}, {1, 1}, {0, 1}}
+	if tris := Delaunay(square); len(tris) != 2 {
+		t.Errorf("delaunay, square expected 2 triangles, got %v", tris)
+	}
+
+	// center point and duplicates
+	points := []Point{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {1, 1}, {2, 2}, {0, 0}}
+	tris := Delaunay(points)
+	if len(tris) != 4 {
+		t.Errorf("delaunay, expected 4 triangles, got %v", tris)
+	}
+
+	for _, tri := range tris {
+		for _, i := range tri {
+			if i > 4 {
+				t.Errorf("delaunay, duplicate points should be skipped, got %v", tri)
+			}
+		}
+
+		if tri[0] != 4 && tri[1] != 4 && tri[2] != 4 {
+			t.Errorf("delaunay, all triangles should use the center, got %v", tri)
+		}
+	}
+
+	// origin first should not change the result
+	origin := []Point{{0, 0}, {100, 100}, {101, 100}, {100, 101}}
+	if tris := Delaunay(origin); len(tris) != 3 {
+		t.Errorf("delaunay, with origin first expected 3 triangles, got %v", tris)
+	}
+
+	// degenerate sets
+	degenerate := [][]Point{
+		nil,
+		{{0, 0}, {1, 1}},
+		{{0, 0}, {1, 1}, {0, 0}, {1, 1}},
+		{{0, 0}, {1, 1}, {2, 2}, {3, 3}},
+	}
+
+	for i, points := range degenerate {
+		if tris := Delaunay(points); len(tris) != 0 {
+			t.Errorf("delaunay, degenerate set %d should have no triangles, got %v", i, tris)
+		}
+	}
+}
+
+func TestDelaunayProperties(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	points := make([]Point, 100)
+	for i := range points {
+		points[i] = Point{r.Float64() * 100, r.Float64() * 100}
+	}
+
+	tris := Delaunay(points)
+
+	// for points in general position, triangles = 2n - 2 - hull points
+	if l := len(tris); l < 150 || l > 2*len(points)-5 {
+		t.Errorf("delaunay, unexpected number of triangles, got %d", l)
+	}
+
+	for _, tri := range tris {
+		a, b, c := &points[tri[0]], &points[tri[1]], &points[tri[2]]
+		if (b[0]-a[0])*(c[1]-a[1])-(b[1]-a[1])*(c[0]-a[0]) <= 0 {
+			t.Fatalf("delaunay, triangle should be counter-clockwise, got %v", tri)
+		}
+
+		for i := range points {
+			if i == tri[0] || i == tri[1] || i == tri[2] {
+				continue
+			}
+
+			if inCircumcircle(a, b, c, &points[i]) {
+				t.Fatalf("delaunay, point %d inside circumcircle of %v", i, tri)
+			}
+		}
+	}
+}
+
+func TestDelaunayCoversHull(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	sets := [][]Point{
+		{{1, 0}, {7, 3}, {8, 0}, {9, 8}, {5, 7}, {7, 7}, {0, 6}},
+	}
+
+	for i := 0; i < 50; i++ {
+		uniform := make([]Point, 20)
+		thin := make([]Point, 20)
+		far := make([]Point, 20)
+		for j := range uniform {
+			uniform[j] = Point{r.Float64() * 10, r.Float64() * 10}
+			thin[j] = Point{r.Float64() * 100, r.Float64() * 0.01}
+			far[j] = Point{r.Float64(), r.Float64()}
+		}
+		far[0] = Point{1000, 1000}
+
+		sets = append(sets, uniform, thin, far)
+	}
+
+	for i, points := range sets {
+		tris := Delaunay(points)
+
+		area := 0.0
+		edges := make(map[[2]Point]bool)
+		for _, tri := range tris {
+			a, b, c := points[tri[0]], points[tri[1]], points[tri[2]]
+			area += ringArea([]Point{a, b, c})
+
+			edges[[2]Point{a, b}] = true
+			edges[[2]Point{b, c}] = true
+			edges[[2]Point{c, a}] = true
+		}
+
+		hull := ConvexHull(points)
+		if expected := ringArea(hull.points[:hull.Length()-1]); math.Abs(area-expected) > 1e-9*expected {
+			t.Errorf("delaunay, set %d triangles should cover the hull area %v, got %v", i, expected, area)
+		}
+
+		for j := 0; j < hull.Length()-1; j++ {
+			if !edges[[2]Point{hull.points[j], hull.points[j+1]}] {
+				t.Errorf("delaunay, set %d hull edge %v %v should be in a triangle", i, hull.points[j], hull.points[j+1])
+			}
+		}
+	}
+}
+
+func TestVoronoi(t *testing.T) {
+	clip := NewBound(0, 4, 0, 2)
+	points := []Point{{1, 1}, {3, 1}, {1, 1}}
+
+	cells := Voronoi(points, clip)
+	if len(cells) != 3 {
+		t.Fatalf("voronoi, expected 3 cells, got %d", len(cells))
+	}
+
+	if b := cells[0].Bound(); !b.Equals(NewBound(0, 2, 0, 2)) {
+		t.Errorf("voronoi, expected left cell, got %v", b)
+	}
+
+	if b := cells[1].Bound(); !b.Equals(NewBound(2, 4, 0, 2)) {
+		t.Errorf("voronoi, expected right cell, got %v", b)
+	}
+
+	if !cells[2].Equals(cells[0]) || cells[2] == cells[0] {
+		t.Errorf("voronoi, duplicate point should have a copy of the same cell, got %v", cells[2])
+	}
+
+	if errs := cells[0].Validate(); len(errs) != 0 {
+		t.Errorf("voronoi, cell should be valid, got %v", errs)
+	}
+
+	// single point is the whole bound
+	cells = Voronoi([]Point{{1, 1}}, clip)
+	if b := cells[0].Bound(); !b.Equals(clip) {
+		t.Errorf("voronoi, single point cell should be the bound, got %v", b)
+	}
+
+	// point whose cell is outside the bound
+	cells = Voronoi([]Point{{1, 1}, {10, 1}}, clip)
+	if cells[1] != nil {
+		t.Errorf("voronoi, cell outside of bound should be nil, got %v", cells[1])
+	}
+}
+
+func TestVoronoiProperties(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	points := make([]Point, 50)
+	for i := range points {
+		points[i] = Point{r.Float64() * 100, r.Float64() * 100}
+	}
+
+	clip := NewBound(-10, 110, -10, 110)
+	cells := Voronoi(points, clip)
+
+	total := 0.0
+	for i, cell := range cells {
+		ring := cell.Outer().Points()
+		ring = ring[:len(ring)-1]
+
+		area := ringArea(ring)
+		if area <= 0 {
+			t.Errorf("voronoi, cell %d should be counter-clockwise, got area %f", i, area)
+		}
+		total += area
+
+		if !cell.Outer().Contains(&points[i]) {
+			t.Errorf("voronoi, cell %d should contain its point", i)
+		}
+
+		// should match clipping by every other point
+		expected := []Point{
+			*clip.SouthWest(), *clip.SouthEast(),
+			*clip.NorthEast(), *clip.NorthWest(),
+		}
+		for j := range points {
+			if i != j {
+				expected = clipToHalfPlane(expected, &points[i], &points[j])
+			}
+		}
+
+		if e := ringArea(expected); math.Abs(e-area) > 1e-6 {
+			t.Errorf("voronoi, cell %d expected area %f, got %f", i, e, area)
+		}
+	}
+
+	if a := clip.Width() * clip.Height(); math.Abs(total-a) > 1e-6 {
+		t.Errorf("voronoi, cells should cover the bound, expected %f, got %f", a, total)
+	}
+}
@@ -0,0 +1,63 @@
+package geo
+
+// ConvexHull returns the convex hull of the points as a closed, counter-clockwise,
+// ring using Andrew's monotone chain algorithm. Collinear points on the hull are not
+// included. If all the points are collinear the ring will go from one end to the other
+// and back. Duplicate points are ignored, so a single distinct point gives a ring
+// of that point twice. Returns an empty path if there are no points. The input is not modified.
+func ConvexHull(points []Point) *Path {
+	if len(points) == 0 {
+		return NewPath()
+	}
+
+	sorted := make([]Point, len(points))
+	copy(sorted, points)
+	SortPoints(sorted)
+
+	// remove duplicates, they are next to each other once sorted
+	unique := sorted[:1]
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i] != unique[len(unique)-1] {
+			unique = append(unique, sorted[i])
+		}
+	}
+	sorted = unique
+
+	hull := make([]Point, 0, 2*len(sorted))
+
+	// lower hull
+	for i := range sorted {
+		for len(hull) >= 2 && cross(&hull[len(hull)-2], &hull[len(hull)-1], &sorted[i]) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, sorted[i])
+	}
+
+	// upper hull, the last point of the lower hull is the start
+	lower := len(hull) + 1
+	for i := len(sorted) - 2; i >= 0; i-- {
+		for len(hull) >= lower && cross(&hull[len(hull)-2], &hull[len(hull)-1], &sorted[i]) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, sorted[i])
+	}
+
+	// single point, the upper hull loop never runs
+	if len(hull) == 1 {
+		hull = append(hull, hull[0])
+	}
+
+	return NewPath().SetPoints(hull)
+}
+
+// ConvexHull returns the convex hull of the points of the path, see ConvexHull.
+// Returns a new path and DOES NOT modify the original.
+func (p *Path) ConvexHull() *Path {
+	return ConvexHull(p.points)
+}
+
+// cross returns the z component of the cross product of o->a and o->b,
+// positive if o, a, b is a counter-clockwise turn.
+func cross(o, a, b *Point) float64 {
+	return (a[0]-o[0])*(b[1]-o[1]) - (a[1]-o[1])*(b[0]-o[0])
+}
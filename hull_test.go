@@ -0,0 +1,74 @@
+package geo
+
+import "testing"
+
+func TestConvexHull(t *testing.T) {
+	points := []Point{{1, 1}, {0, 0}, {2, 0}, {2, 2}, {0, 2}, {1, 2}, {0.5, 1.5}}
+
+	expected := NewPath()
+	expected.Push(NewPoint(0, 0))
+	expected.Push(NewPoint(2, 0))
+	expected.Push(NewPoint(2, 2))
+	expected.Push(NewPoint(0, 2))
+	expected.Push(NewPoint(0, 0))
+
+	if h := ConvexHull(points); !h.Equals(expected) {
+		t.Errorf("hull, convexHull expected %v, got %v", expected, h)
+	}
+
+	// should not modify the input
+	if !points[0].Equals(NewPoint(1, 1)) {
+		t.Errorf("hull, convexHull should not modify the input, got %v", points)
+	}
+
+	if l := ConvexHull(nil).Length(); l != 0 {
+		t.Errorf("hull, convexHull of no points should be empty, got %d", l)
+	}
+
+	if h := ConvexHull([]Point{{1, 2}}); h.Length() != 2 || !h.GetAt(1).Equals(NewPoint(1, 2)) {
+		t.Errorf("hull, convexHull of a point should be a closed ring, got %v", h)
+	}
+
+	// duplicates should not change the result
+	if h := ConvexHull([]Point{{1, 2}, {1, 2}, {1, 2}}); h.Length() != 2 || !h.GetAt(1).Equals(NewPoint(1, 2)) {
+		t.Errorf("hull, convexHull of the same point should be a closed ring of 2, got %v", h)
+	}
+
+	duplicates := append(points, points...)
+	if h := ConvexHull(duplicates); !h.Equals(expected) {
+		t.Errorf("hull, convexHull with duplicates expected %v, got %v", expected, h)
+	}
+
+	line := NewPath()
+	line.Push(NewPoint(0, 0))
+	line.Push(NewPoint(1, 1))
+	line.Push(NewPoint(0, 0))
+	if h := ConvexHull([]Point{{1, 1}, {0, 0}, {1, 1}, {0, 0}}); !h.Equals(line) {
+		t.Errorf("hull, convexHull of duplicated segment expected %v, got %v", line, h)
+	}
+}
+
+func TestPathConvexHull(t *testing.T) {
+	// a concave u shape
+	p := NewPath()
+	p.Push(NewPoint(0, 2))
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(2, 0))
+	p.Push(NewPoint(2, 2))
+	p.Push(NewPoint(1, 1))
+
+	expected := NewPath()
+	expected.Push(NewPoint(0, 0))
+	expected.Push(NewPoint(2, 0))
+	expected.Push(NewPoint(2, 2))
+	expected.Push(NewPoint(0, 2))
+	expected.Push(NewPoint(0, 0))
+
+	if h := p.ConvexHull(); !h.Equals(expected) {
+		t.Errorf("path, convexHull expected %v, got %v", expected, h)
+	}
+
+	if !p.GetAt(0).Equals(NewPoint(0, 2)) || p.Length() != 5 {
+		t.Errorf("path, convexHull should not modify the path, got %v", p)
+	}
+}
@@ -1,10 +1,243 @@
 package geo
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 )
 
+// A Geometry is one of the concrete geo types, *Point, *Line, *Path or *Polygon.
+// It is returned when the type of the data is not known ahead of time, like from UnmarshalGeoJSON.
+type Geometry interface {
+	Bound() *Bound
+}
+
+// UnmarshalGeoJSON decodes a GeoJSON geometry object, using the "type" field
+// to decide which concrete type to return. Points become a *Point,
+// LineStrings a *Path and Polygons a *Polygon.
+func UnmarshalGeoJSON(data []byte) (Geometry, error) {
+	object := struct {
+		Type        string          `json:"type"`
+		Coordinates json.RawMessage `json:"coordinates"`
+	}{}
+
+	err := json.Unmarshal(data, &object)
+	if err != nil {
+		return nil, err
+	}
+
+	if object.Type == "" {
+		return nil, errors.New("geo: geojson geometry type missing")
+	}
+
+	if len(object.Coordinates) == 0 {
+		return nil, fmt.Errorf("geo: geojson %s coordinates missing", object.Type)
+	}
+
+	var g Geometry
+	switch object.Type {
+	case "Point":
+		g = &Point{}
+	case "LineString":
+		g = NewPath()
+	case "Polygon":
+		g = &Polygon{}
+	default:
+		return nil, fmt.Errorf("geo: unsupported geojson geometry type: %s", object.Type)
+	}
+
+	err = json.Unmarshal(object.Coordinates, g)
+	if err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// GeoJSONOptions configures MarshalGeoJSON.
+type GeoJSONOptions struct {
+	// Precision is the number of decimal places the coordinates are rounded to,
+	// e.g. 6 is about 0.1 meters for lng/lat data. 0 or negative keeps full precision.
+	Precision int
+}
+
+// MarshalGeoJSON encodes the geometry as a GeoJSON geometry object, the inverse of
+// UnmarshalGeoJSON. Points become a Point, lines and paths a LineString and polygons
+// a Polygon. The coordinates are rounded as given by the options, the geometry is not modified.
+func MarshalGeoJSON(geom Geometry, opts GeoJSONOptions) ([]byte, error) {
+	object := struct {
+		Type        string   `json:"type"`
+		Coordinates Geometry `json:"coordinates"`
+	}{}
+
+	switch g := geom.(type) {
+	case *Point:
+		object.Type, object.Coordinates = "Point", g.Clone()
+	case *Line:
+		object.Type, object.Coordinates = "LineString", NewPath().Push(g.A()).Push(g.B())
+	case *Path:
+		object.Type, object.Coordinates = "LineString", g.Clone()
+	case *Polygon:
+		object.Type, object.Coordinates = "Polygon", g.Clone()
+	default:
+		return nil, fmt.Errorf("geo: unsupported geojson geometry: %T", geom)
+	}
+
+	if opts.Precision > 0 {
+		round := func(p *Point) { p.Round(opts.Precision) }
+
+		switch g := object.Coordinates.(type) {
+		case *Point:
+			g.Transform(round)
+		case *Path:
+			g.Transform(round)
+		case *Polygon:
+			g.Transform(round)
+		}
+	}
+
+	return json.Marshal(object)
+}
+
+// PathFromGeoJSON decodes a LineString from a bare GeoJSON geometry, a Feature
+// or the first feature of a FeatureCollection. An error is returned if the geometry
+// is not a LineString.
+func PathFromGeoJSON(data []byte) (*Path, error) {
+	g, err := unwrapGeoJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	p, ok := g.(*Path)
+	if !ok {
+		return nil, fmt.Errorf("geo: geojson geometry is a %T, not a LineString", g)
+	}
+
+	return p, nil
+}
+
+// PolygonFromGeoJSON decodes a Polygon from a bare GeoJSON geometry, a Feature
+// or the first feature of a FeatureCollection. An error is returned if the geometry
+// is not a Polygon.
+func PolygonFromGeoJSON(data []byte) (*Polygon, error) {
+	g, err := unwrapGeoJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	p, ok := g.(*Polygon)
+	if !ok {
+		return nil, fmt.Errorf("geo: geojson geometry is a %T, not a Polygon", g)
+	}
+
+	return p, nil
+}
+
+// unwrapGeoJSON removes any Feature or FeatureCollection envelope
+// and decodes the first geometry found.
+func unwrapGeoJSON(data []byte) (Geometry, error) {
+	object := struct {
+		Type     string            `json:"type"`
+		Geometry json.RawMessage   `json:"geometry"`
+		Features []json.RawMessage `json:"features"`
+	}{}
+
+	err := json.Unmarshal(data, &object)
+	if err != nil {
+		return nil, err
+	}
+
+	switch object.Type {
+	case "FeatureCollection":
+		if len(object.Features) == 0 {
+			return nil, errors.New("geo: geojson feature collection is empty")
+		}
+
+		return unwrapGeoJSON(object.Features[0])
+	case "Feature":
+		if len(object.Geometry) == 0 || string(object.Geometry) == "null" {
+			return nil, errors.New("geo: geojson feature geometry missing")
+		}
+
+		return UnmarshalGeoJSON(object.Geometry)
+	}
+
+	return UnmarshalGeoJSON(data)
+}
+
+// GeoJSONType returns the "type" of the GeoJSON object without decoding the rest,
+// such as the coordinates. The object is read only until the type is found, so
+// anything after it is not validated. Useful to decide how to parse the data.
+func GeoJSONType(data []byte) (string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+
+	token, err := decoder.Token()
+	if err != nil {
+		return "", err
+	}
+
+	if d, ok := token.(json.Delim); !ok || d != '{' {
+		return "", errors.New("geo: geojson must be an object")
+	}
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return "", err
+		}
+
+		if token == "type" {
+			var t string
+			if err := decoder.Decode(&t); err != nil {
+				return "", err
+			}
+
+			return t, nil
+		}
+
+		// skip the value of other fields
+		var skip json.RawMessage
+		if err := decoder.Decode(&skip); err != nil {
+			return "", err
+		}
+	}
+
+	return "", errors.New("geo: geojson geometry type missing")
+}
+
+// DecodePointStream reads a JSON array of [x, y] pairs from the reader, calling emit
+// with each point as it is decoded. The whole array is never held in memory so this
+// is useful for very large inputs. Decoding stops at the first error returned by emit.
+func DecodePointStream(r io.Reader, emit func(*Point) error) error {
+	decoder := json.NewDecoder(r)
+
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	if d, ok := token.(json.Delim); !ok || d != '[' {
+		return errors.New("geo: point stream must be an array")
+	}
+
+	for decoder.More() {
+		p := &Point{}
+		if err := decoder.Decode(p); err != nil {
+			return err
+		}
+
+		if err := emit(p); err != nil {
+			return err
+		}
+	}
+
+	// the closing ]
+	_, err = decoder.Token()
+	return err
+}
+
 // MarshalJSON enables lines to be encoded as JSON using the encoding/json package.
 func (l *Line) MarshalJSON() ([]byte, error) {
 	return json.Marshal([2]Point{l.a, l.b})
@@ -51,6 +284,32 @@ func (p *Path) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON enables polygons to be encoded as JSON using the encoding/json package.
+// The result is an array of rings, outer ring first, the same as GeoJSON polygon coordinates.
+func (p *Polygon) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.rings())
+}
+
+// UnmarshalJSON enables polygons to be decoded as JSON using the encoding/json package.
+// The first ring is taken to be the outer ring, the rest are the holes.
+func (p *Polygon) UnmarshalJSON(data []byte) error {
+	var rings []*Path
+
+	err := json.Unmarshal(data, &rings)
+	if err != nil {
+		return err
+	}
+
+	if len(rings) == 0 {
+		return errors.New("geo: not enough rings to unmarshal into polygon")
+	}
+
+	p.outer = rings[0]
+	p.holes = rings[1:]
+
+	return nil
+}
+
 // MarshalJSON enables bounds to be encoded as JSON using the encoding/json package.
 func (b *Bound) MarshalJSON() ([]byte, error) {
 	return json.Marshal([2]*Point{b.sw, b.ne})
@@ -73,9 +332,9 @@ func (b *Bound) UnmarshalJSON(data []byte) error {
 		return errors.New("geo: not enough points to unmarshal into bound")
 	}
 
-	b.sw = points[0]
-	b.ne = points[0].Clone()
-	b.Extend(points[1])
+	nb := NewBoundFromPoints(points[0], points[1])
+	b.sw = nb.sw
+	b.ne = nb.ne
 
 	return nil
 }
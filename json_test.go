@@ -2,6 +2,8 @@ package geo
 
 import (
 	"encoding/json"
+	"errors"
+	"strings"
 	"testing"
 )
 
@@ -104,6 +106,273 @@ func TestPathJSON(t *testing.T) {
 	}
 }
 
+func TestPolygonJSON(t *testing.T) {
+	outer := NewPath()
+	outer.Push(NewPoint(0, 0))
+	outer.Push(NewPoint(4, 0))
+	outer.Push(NewPoint(0, 4))
+	outer.Push(NewPoint(0, 0))
+
+	hole := NewPath()
+	hole.Push(NewPoint(1, 1))
+	hole.Push(NewPoint(2, 1))
+	hole.Push(NewPoint(1, 2))
+	hole.Push(NewPoint(1, 1))
+
+	p1 := NewPolygon(outer, hole)
+
+	data, err := json.Marshal(p1)
+	if err != nil {
+		t.Errorf("should marshal just fine, %v", err)
+	}
+
+	if string(data) != "[[[0,0],[4,0],[0,4],[0,0]],[[1,1],[2,1],[1,2],[1,1]]]" {
+		t.Errorf("json encoding incorrect, got %v", string(data))
+	}
+
+	var p2 *Polygon
+	err = json.Unmarshal(data, &p2)
+	if err != nil {
+		t.Errorf("should unmarshal just fine, %v", err)
+	}
+
+	if !p1.Equals(p2) {
+		t.Errorf("unmarshal incorrect, got %v", p2)
+	}
+
+	err = json.Unmarshal([]byte("[]"), &p2)
+	if err == nil {
+		t.Errorf("should get error since there is no outer ring")
+	}
+}
+
+func TestGeoJSONType(t *testing.T) {
+	tests := map[string]string{
+		`{"type":"Point","coordinates":[1,2]}`:                        "Point",
+		`{"coordinates":[[1,2],[3,4]],"type":"LineString"}`:           "LineString",
+		`{"bbox":[1,2,3,4],"coordinates":[[[1,2]]],"type":"Polygon"}`: "Polygon",
+		`{"type":"Feature","geometry":{"type":"Point"}}`:              "Feature",
+		`{"type":"Point","coordinates":[1,`:                           "Point", // not read past the type
+	}
+
+	for data, expected := range tests {
+		typ, err := GeoJSONType([]byte(data))
+		if err != nil {
+			t.Errorf("geojson type, unexpected error for %s: %v", data, err)
+		}
+
+		if typ != expected {
+			t.Errorf("geojson type, expected %s, got %s", expected, typ)
+		}
+	}
+
+	for _, data := range []string{`{"coordinates":[1,2]}`, `[1,2]`, `{"type":1}`, ``, `{"coordinates":[1,`} {
+		if _, err := GeoJSONType([]byte(data)); err == nil {
+			t.Errorf("geojson type, expected error for %s", data)
+		}
+	}
+}
+
+func TestDecodePointStream(t *testing.T) {
+	path := NewPath()
+	err := DecodePointStream(strings.NewReader(`[[1,2], [3,4],[5.5,-6]]`), func(p *Point) error {
+		path.Push(p)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("json, decodePointStream error: %v", err)
+	}
+
+	expected := NewPath()
+	expected.Push(NewPoint(1, 2))
+	expected.Push(NewPoint(3, 4))
+	expected.Push(NewPoint(5.5, -6))
+	if !path.Equals(expected) {
+		t.Errorf("json, decodePointStream expected %v, got %v", expected, path)
+	}
+
+	// empty array
+	count := 0
+	err = DecodePointStream(strings.NewReader(`[]`), func(p *Point) error {
+		count++
+		return nil
+	})
+	if err != nil || count != 0 {
+		t.Errorf("json, decodePointStream empty array should emit nothing, got %d, %v", count, err)
+	}
+
+	// emit errors stop decoding
+	stop := errors.New("stop")
+	count = 0
+	err = DecodePointStream(strings.NewReader(`[[1,2],[3,4]]`), func(p *Point) error {
+		count++
+		return stop
+	})
+	if err != stop || count != 1 {
+		t.Errorf("json, decodePointStream should stop on emit error, got %d, %v", count, err)
+	}
+
+	for _, data := range []string{`{"type":"Point"}`, `[[1,2],"a"]`, `[[1,2]`, ``} {
+		err := DecodePointStream(strings.NewReader(data), func(p *Point) error { return nil })
+		if err == nil {
+			t.Errorf("json, decodePointStream expected error for %s", data)
+		}
+	}
+}
+
+func TestUnmarshalGeoJSON(t *testing.T) {
+	g, err := UnmarshalGeoJSON([]byte(`{"type":"Point","coordinates":[1.5,2.5]}`))
+	if err != nil {
+		t.Fatalf("should unmarshal just fine, %v", err)
+	}
+
+	if p, ok := g.(*Point); !ok || !p.Equals(NewPoint(1.5, 2.5)) {
+		t.Errorf("unmarshal geojson point incorrect, got %v", g)
+	}
+
+	g, err = UnmarshalGeoJSON([]byte(`{"type":"LineString","coordinates":[[1,2],[3,4],[5,6]]}`))
+	if err != nil {
+		t.Fatalf("should unmarshal just fine, %v", err)
+	}
+
+	answer := NewPath()
+	answer.Push(NewPoint(1, 2))
+	answer.Push(NewPoint(3, 4))
+	answer.Push(NewPoint(5, 6))
+	if p, ok := g.(*Path); !ok || !p.Equals(answer) {
+		t.Errorf("unmarshal geojson linestring incorrect, got %v", g)
+	}
+
+	g, err = UnmarshalGeoJSON([]byte(`{"type":"Polygon","coordinates":[[[0,0],[4,0],[0,4],[0,0]],[[1,1],[2,1],[1,2],[1,1]]]}`))
+	if err != nil {
+		t.Fatalf("should unmarshal just fine, %v", err)
+	}
+
+	if p, ok := g.(*Polygon); !ok || p.Outer().Length() != 4 || len(p.Holes()) != 1 {
+		t.Errorf("unmarshal geojson polygon incorrect, got %v", g)
+	}
+
+	// errors
+	_, err = UnmarshalGeoJSON([]byte(`{"type":"MultiCurve","coordinates":[]}`))
+	if err == nil || !strings.Contains(err.Error(), "MultiCurve") {
+		t.Errorf("should get error naming the unsupported type, got %v", err)
+	}
+
+	_, err = UnmarshalGeoJSON([]byte(`{"coordinates":[1,2]}`))
+	if err == nil {
+		t.Errorf("should get error since type is missing")
+	}
+
+	_, err = UnmarshalGeoJSON([]byte(`{"type":"Point"}`))
+	if err == nil {
+		t.Errorf("should get error since coordinates are missing")
+	}
+
+	_, err = UnmarshalGeoJSON([]byte(`{"type":"LineString","coordinates":[1,2]}`))
+	if err == nil {
+		t.Errorf("should get error since coordinates do not match the type")
+	}
+}
+
+func TestMarshalGeoJSON(t *testing.T) {
+	path := NewPath()
+	path.Push(NewPoint(1.123456789, 2.000000049))
+	path.Push(NewPoint(3, 4))
+
+	type testCase struct {
+		geom     Geometry
+		opts     GeoJSONOptions
+		expected string
+	}
+
+	tests := []testCase{
+		{NewPoint(1.123456789, 2), GeoJSONOptions{}, `{"type":"Point","coordinates":[1.123456789,2]}`},
+		{NewPoint(1.123456789, 2), GeoJSONOptions{Precision: 3}, `{"type":"Point","coordinates":[1.123,2]}`},
+		{path, GeoJSONOptions{Precision: 6}, `{"type":"LineString","coordinates":[[1.123457,2],[3,4]]}`},
+		{path, GeoJSONOptions{Precision: -1}, `{"type":"LineString","coordinates":[[1.123456789,2.000000049],[3,4]]}`},
+		{NewLine(NewPoint(1.06, 2), NewPoint(3, 4)), GeoJSONOptions{Precision: 1}, `{"type":"LineString","coordinates":[[1.1,2],[3,4]]}`},
+		{NewPolygon(path), GeoJSONOptions{Precision: 2}, `{"type":"Polygon","coordinates":[[[1.12,2],[3,4]]]}`},
+	}
+
+	for i, test := range tests {
+		data, err := MarshalGeoJSON(test.geom, test.opts)
+		if err != nil {
+			t.Errorf("json, marshalGeoJSON %d error: %v", i, err)
+			continue
+		}
+
+		if string(data) != test.expected {
+			t.Errorf("json, marshalGeoJSON %d expected %s, got %s", i, test.expected, data)
+		}
+
+		if _, err := UnmarshalGeoJSON(data); err != nil {
+			t.Errorf("json, marshalGeoJSON %d should unmarshal, got %v", i, err)
+		}
+	}
+
+	// should not modify the geometry
+	if path.GetAt(0)[0] != 1.123456789 {
+		t.Errorf("json, marshalGeoJSON should not modify the geometry, got %v", path)
+	}
+
+	if _, err := MarshalGeoJSON(&Surface{}, GeoJSONOptions{}); err == nil {
+		t.Error("json, marshalGeoJSON expected error for surface")
+	}
+}
+
+func TestPathFromGeoJSON(t *testing.T) {
+	expected := NewPath()
+	expected.Push(NewPoint(1, 2))
+	expected.Push(NewPoint(3, 4))
+
+	geometry := `{"type":"LineString","coordinates":[[1,2],[3,4]]}`
+	feature := `{"type":"Feature","properties":{"name":"a"},"geometry":` + geometry + `}`
+	collection := `{"type":"FeatureCollection","features":[` + feature + `,{"type":"Feature","geometry":null}]}`
+
+	for _, data := range []string{geometry, feature, collection} {
+		p, err := PathFromGeoJSON([]byte(data))
+		if err != nil {
+			t.Errorf("json, pathFromGeoJSON error for %s: %v", data, err)
+			continue
+		}
+
+		if !p.Equals(expected) {
+			t.Errorf("json, pathFromGeoJSON expected %v, got %v", expected, p)
+		}
+	}
+
+	errorCases := []string{
+		`{"type":"Point","coordinates":[1,2]}`,
+		`{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]}}`,
+		`{"type":"Feature","geometry":null}`,
+		`{"type":"FeatureCollection","features":[]}`,
+		`[1,2]`,
+	}
+
+	for _, data := range errorCases {
+		if _, err := PathFromGeoJSON([]byte(data)); err == nil {
+			t.Errorf("json, pathFromGeoJSON expected error for %s", data)
+		}
+	}
+}
+
+func TestPolygonFromGeoJSON(t *testing.T) {
+	data := `{"type":"Feature","geometry":{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]]}}`
+	p, err := PolygonFromGeoJSON([]byte(data))
+	if err != nil {
+		t.Fatalf("json, polygonFromGeoJSON error: %v", err)
+	}
+
+	if l := p.Outer().Length(); l != 4 {
+		t.Errorf("json, polygonFromGeoJSON expected 4 points, got %d", l)
+	}
+
+	data = `{"type":"LineString","coordinates":[[1,2],[3,4]]}`
+	if _, err := PolygonFromGeoJSON([]byte(data)); err == nil {
+		t.Error("json, polygonFromGeoJSON expected error for line string")
+	}
+}
+
 func TestBoundJSON(t *testing.T) {
 	b1 := NewBound(1, 2, 3, 4)
 
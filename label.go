@@ -0,0 +1,164 @@
+package geo
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// PointOnSurface returns the pole of inaccessibility of the path treated as a closed ring,
+// the interior point farthest from the boundary, within precision. Unlike the centroid it is
+// always inside the ring, even for concave shapes, so it is a good place for a label.
+// Uses the grid refinement search of Mapbox's polylabel. Returns nil for empty paths and a copy
+// of the first point if the ring has no area, e.g. all the points are collinear.
+// Panics if precision is not positive.
+func (p *Path) PointOnSurface(precision float64) *Point {
+	if precision <= 0 {
+		panic(fmt.Sprintf("geo: point on surface precision must be positive, given %f", precision))
+	}
+
+	if len(p.points) == 0 {
+		return nil
+	}
+
+	ring := openRing(p.points)
+	area := ringSignedArea(ring)
+	if area == 0 {
+		return p.points[0].Clone()
+	}
+
+	b := p.Bound()
+	cellSize := math.Min(b.Width(), b.Height())
+
+	// cover the bound with square cells
+	cells := &labelCells{}
+	h := cellSize / 2
+	for x := b.sw[0]; x < b.ne[0]; x += cellSize {
+		for y := b.sw[1]; y < b.ne[1]; y += cellSize {
+			heap.Push(cells, p.newLabelCell(x+h, y+h, h))
+		}
+	}
+
+	// start from the best of a few guesses, the scanline point is always inside
+	best := p.newLabelCell(b.Center()[0], b.Center()[1], 0)
+	for _, guess := range []*Point{ringCentroid(ring, area), ringScanlinePoint(ring, b)} {
+		if guess == nil {
+			continue
+		}
+
+		if cell := p.newLabelCell(guess[0], guess[1], 0); cell.distance > best.distance {
+			best = cell
+		}
+	}
+
+	for cells.Len() > 0 {
+		cell := heap.Pop(cells).(labelCell)
+
+		if cell.distance > best.distance {
+			best = cell
+		}
+
+		// no better solution possible in this cell
+		if cell.max-best.distance <= precision {
+			continue
+		}
+
+		h := cell.half / 2
+		heap.Push(cells, p.newLabelCell(cell.center[0]-h, cell.center[1]-h, h))
+		heap.Push(cells, p.newLabelCell(cell.center[0]+h, cell.center[1]-h, h))
+		heap.Push(cells, p.newLabelCell(cell.center[0]-h, cell.center[1]+h, h))
+		heap.Push(cells, p.newLabelCell(cell.center[0]+h, cell.center[1]+h, h))
+	}
+
+	return best.center.Clone()
+}
+
+// ringCentroid returns the area weighted centroid of the open ring with the given signed area.
+func ringCentroid(ring []Point, area float64) *Point {
+	x, y := 0.0, 0.0
+
+	j := len(ring) - 1
+	for i := range ring {
+		f := ring[j][0]*ring[i][1] - ring[i][0]*ring[j][1]
+		x += (ring[j][0] + ring[i][0]) * f
+		y += (ring[j][1] + ring[i][1]) * f
+		j = i
+	}
+
+	return &Point{x / (6 * area), y / (6 * area)}
+}
+
+// ringScanlinePoint returns the middle of the widest interior span of the ring
+// along the horizontal line through the middle of the bound. Crossings are found
+// with the same rule as ringContains, so the point is inside. Returns nil if
+// the line does not pass through the interior.
+func ringScanlinePoint(ring []Point, b *Bound) *Point {
+	y := (b.sw[1] + b.ne[1]) / 2
+
+	var xs []float64
+	j := len(ring) - 1
+	for i := range ring {
+		a, c := ring[i], ring[j]
+		if (a[1] > y) != (c[1] > y) {
+			xs = append(xs, (c[0]-a[0])*(y-a[1])/(c[1]-a[1])+a[0])
+		}
+		j = i
+	}
+
+	sort.Float64s(xs)
+
+	var result *Point
+	width := 0.0
+	for i := 1; i < len(xs); i += 2 {
+		if w := xs[i] - xs[i-1]; w > width {
+			width = w
+			result = &Point{(xs[i] + xs[i-1]) / 2, y}
+		}
+	}
+
+	return result
+}
+
+// labelCell is a square cell in the PointOnSurface search.
+type labelCell struct {
+	center   Point
+	half     float64 // half the cell size
+	distance float64 // signed distance from the center to the ring, positive inside
+	max      float64 // max possible distance within the cell
+}
+
+func (p *Path) newLabelCell(x, y, half float64) labelCell {
+	c := labelCell{center: Point{x, y}, half: half}
+
+	// include the closing segment if the ring is not closed
+	dist := p.SquaredDistanceFrom(&c.center)
+	if last := len(p.points) - 1; !p.points[0].Equals(&p.points[last]) {
+		l := &Line{p.points[last], p.points[0]}
+		dist = math.Min(dist, l.SquaredDistanceFrom(&c.center))
+	}
+
+	c.distance = math.Sqrt(dist)
+	if !ringContains(p.points, &c.center) {
+		c.distance = -c.distance
+	}
+
+	c.max = c.distance + half*math.Sqrt2
+	return c
+}
+
+// labelCells is a max heap of cells ordered by their max possible distance.
+type labelCells []labelCell
+
+func (c labelCells) Len() int            { return len(c) }
+func (c labelCells) Less(i, j int) bool  { return c[i].max > c[j].max }
+func (c labelCells) Swap(i, j int)       { c[i], c[j] = c[j], c[i] }
+func (c *labelCells) Push(x interface{}) { *c = append(*c, x.(labelCell)) }
+
+func (c *labelCells) Pop() interface{} {
+	old := *c
+	cell := old[len(old)-1]
+	*c = old[:len(old)-1]
+
+	return cell
+}
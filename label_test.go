@@ -0,0 +1,78 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPathPointOnSurface(t *testing.T) {
+	// square, the center is the farthest from the edges
+	p := testSquare(0, 0, 10)
+	if c := p.PointOnSurface(0.01); c.DistanceFrom(NewPoint(5, 5)) > 0.1 {
+		t.Errorf("path, pointOnSurface expected near [5, 5], got %v", c)
+	}
+
+	// u shape, the centroid is outside
+	u := NewPath()
+	u.Push(NewPoint(0, 0))
+	u.Push(NewPoint(10, 0))
+	u.Push(NewPoint(10, 10))
+	u.Push(NewPoint(8, 10))
+	u.Push(NewPoint(8, 2))
+	u.Push(NewPoint(2, 2))
+	u.Push(NewPoint(2, 10))
+	u.Push(NewPoint(0, 10))
+
+	c := u.PointOnSurface(0.01)
+	if !u.Contains(c) {
+		t.Errorf("path, pointOnSurface should be inside, got %v", c)
+	}
+
+	// best is in a bottom corner, equidistant from the outer walls and the inner corner
+	expected := 2 * math.Sqrt2 / (1 + math.Sqrt2)
+	if d := u.DistanceFrom(c); math.Abs(d-expected) > 0.01 {
+		t.Errorf("path, pointOnSurface expected distance %f from the boundary, got %f", expected, d)
+	}
+
+	if c := NewPath().PointOnSurface(1); c != nil {
+		t.Errorf("path, pointOnSurface of empty path should be nil, got %v", c)
+	}
+
+	line := NewPath()
+	line.Push(NewPoint(1, 1))
+	line.Push(NewPoint(1, 5))
+	if c := line.PointOnSurface(1); !c.Equals(NewPoint(1, 1)) {
+		t.Errorf("path, pointOnSurface with no area expected first point, got %v", c)
+	}
+
+	diagonal := NewPath()
+	diagonal.Push(NewPoint(0, 0))
+	diagonal.Push(NewPoint(1, 1))
+	diagonal.Push(NewPoint(2, 2))
+	if c := diagonal.PointOnSurface(1); !c.Equals(NewPoint(0, 0)) {
+		t.Errorf("path, pointOnSurface of collinear ring expected first point, got %v", c)
+	}
+
+	// thin L shape, the bound center is outside and coarse precision stops right away
+	thin := NewPath()
+	thin.Push(NewPoint(0, 0))
+	thin.Push(NewPoint(10, 0))
+	thin.Push(NewPoint(10, 0.1))
+	thin.Push(NewPoint(0.1, 0.1))
+	thin.Push(NewPoint(0.1, 10))
+	thin.Push(NewPoint(0, 10))
+
+	if c := thin.PointOnSurface(100); !thin.Contains(c) {
+		t.Errorf("path, pointOnSurface of thin shape should be inside, got %v", c)
+	}
+}
+
+func TestPathPointOnSurfacePanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("path, pointOnSurface should panic for non positive precision")
+		}
+	}()
+
+	testSquare(0, 0, 1).PointOnSurface(0)
+}
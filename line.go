@@ -100,6 +100,27 @@ func (l *Line) Direction() float64 {
 	return math.Atan2(l.b[1]-l.a[1], l.b[0]-l.a[0])
 }
 
+// AngleTo computes the angle between the direction of this line and the given line.
+// The units are radians, in the range [0, Pi]. Returns NaN if either line has zero length.
+func (l *Line) AngleTo(line *Line) float64 {
+	v1 := Point{l.b[0] - l.a[0], l.b[1] - l.a[1]}
+	v2 := Point{line.b[0] - line.a[0], line.b[1] - line.a[1]}
+
+	cross := v1[0]*v2[1] - v1[1]*v2[0]
+	if v1.Dot(&v2) == 0 && cross == 0 {
+		return math.NaN()
+	}
+
+	return math.Abs(math.Atan2(cross, v1.Dot(&v2)))
+}
+
+// IsParallel returns true if the lines point in the same, or opposite, direction
+// to within the given tolerance. The tolerance is in radians.
+func (l *Line) IsParallel(line *Line, tolerance float64) bool {
+	angle := l.AngleTo(line)
+	return angle <= tolerance || math.Pi-angle <= tolerance
+}
+
 // Project returns the normalized distance of the point on the line nearest the given point.
 // Returned values may be outside of [0,1]. This function is the opposite of Interpolate.
 func (l *Line) Project(point *Point) float64 {
@@ -140,6 +161,15 @@ func (l *Line) Interpolate(percent float64) *Point {
 	}
 }
 
+// InterpolateTo is the same as Interpolate but writes the result to the given point,
+// for example one from a PointPool, instead of allocating a new one. Returns the given point.
+func (l *Line) InterpolateTo(percent float64, result *Point) *Point {
+	result[0] = l.a[0] + percent*(l.b[0]-l.a[0])
+	result[1] = l.a[1] + percent*(l.b[1]-l.a[1])
+
+	return result
+}
+
 // Side returns 1 if the point is on the right side, -1 if on the left side, and 0 if collinear.
 func (l *Line) Side(p *Point) int {
 	val := (l.b[0]-l.a[0])*(p[1]-l.b[1]) - (l.b[1]-l.a[1])*(p[0]-l.b[0])
@@ -217,11 +247,159 @@ func (l *Line) Intersects(line *Line) bool {
 	return false
 }
 
+// GeoIntersection finds the intersection of the two lines treated as great circle arcs
+// on the sphere, assuming lng/lat points. Returns nil if the arcs do not intersect,
+// or if they lie on the same great circle. The arcs are the shorter path between the endpoints.
+func (l *Line) GeoIntersection(line *Line) *Point {
+	a1, b1 := geoUnitVector(&l.a), geoUnitVector(&l.b)
+	a2, b2 := geoUnitVector(&line.a), geoUnitVector(&line.b)
+
+	n1 := vectorCross(a1, b1)
+	n2 := vectorCross(a2, b2)
+
+	candidate := vectorCross(n1, n2)
+	length := math.Sqrt(vectorDot(candidate, candidate))
+	if length < 1e-15 {
+		// degenerate arc or both on the same great circle
+		return nil
+	}
+
+	for i := range candidate {
+		candidate[i] /= length
+	}
+
+	// the great circles intersect at two antipodal points, check each
+	for _, sign := range []float64{1, -1} {
+		p := [3]float64{sign * candidate[0], sign * candidate[1], sign * candidate[2]}
+		if geoArcContains(a1, b1, n1, p) && geoArcContains(a2, b2, n2, p) {
+			return &Point{
+				rad2deg(math.Atan2(p[1], p[0])),
+				rad2deg(math.Asin(math.Max(-1, math.Min(1, p[2])))),
+			}
+		}
+	}
+
+	return nil
+}
+
+// geoArcContains checks if the point p, known to be on the great circle with normal n,
+// is between a and b along the shorter arc.
+func geoArcContains(a, b, n, p [3]float64) bool {
+	const tolerance = -1e-12
+	return vectorDot(vectorCross(a, p), n) >= tolerance && vectorDot(vectorCross(p, b), n) >= tolerance
+}
+
+func geoUnitVector(p *Point) [3]float64 {
+	lat := deg2rad(p.Lat())
+	lng := deg2rad(p.Lng())
+
+	return [3]float64{
+		math.Cos(lat) * math.Cos(lng),
+		math.Cos(lat) * math.Sin(lng),
+		math.Sin(lat),
+	}
+}
+
+// geoSlerp interpolates along the great circle between the unit vectors a and b,
+// which are the given angle apart, and returns the lng/lat point.
+func geoSlerp(a, b [3]float64, angle, percent float64) Point {
+	sin := math.Sin(angle)
+	wa := math.Sin((1-percent)*angle) / sin
+	wb := math.Sin(percent*angle) / sin
+
+	x := wa*a[0] + wb*b[0]
+	y := wa*a[1] + wb*b[1]
+	z := wa*a[2] + wb*b[2]
+
+	return Point{
+		rad2deg(math.Atan2(y, x)),
+		rad2deg(math.Atan2(z, math.Sqrt(x*x+y*y))),
+	}
+}
+
+func vectorCross(a, b [3]float64) [3]float64 {
+	return [3]float64{
+		a[1]*b[2] - a[2]*b[1],
+		a[2]*b[0] - a[0]*b[2],
+		a[0]*b[1] - a[1]*b[0],
+	}
+}
+
+func vectorDot(a, b [3]float64) float64 {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
+}
+
+// ClipToBound clips the line to the bound using the Liang-Barsky algorithm.
+// Returns a new line of the visible part and true, or nil and false if
+// no part of the line is within the bound. Does not modify the line.
+func (l *Line) ClipToBound(b *Bound) (*Line, bool) {
+	dx := l.b[0] - l.a[0]
+	dy := l.b[1] - l.a[1]
+
+	t0, t1 := 0.0, 1.0
+
+	p := [4]float64{-dx, dx, -dy, dy}
+	q := [4]float64{
+		l.a[0] - b.sw[0],
+		b.ne[0] - l.a[0],
+		l.a[1] - b.sw[1],
+		b.ne[1] - l.a[1],
+	}
+
+	for i := range p {
+		if p[i] == 0 {
+			// parallel to this edge, outside if beyond it
+			if q[i] < 0 {
+				return nil, false
+			}
+
+			continue
+		}
+
+		r := q[i] / p[i]
+		if p[i] < 0 {
+			if r > t1 {
+				return nil, false
+			}
+			t0 = math.Max(t0, r)
+		} else {
+			if r < t0 {
+				return nil, false
+			}
+			t1 = math.Min(t1, r)
+		}
+	}
+
+	return NewLine(l.Interpolate(t0), l.Interpolate(t1)), true
+}
+
 // Midpoint returns the Euclidean midpoint of the line.
 func (l *Line) Midpoint() *Point {
 	return &Point{(l.a[0] + l.b[0]) / 2, (l.a[1] + l.b[1]) / 2}
 }
 
+// PerpendicularBisector returns the line through the midpoint, perpendicular to this line,
+// with a length of 1 and centered on the midpoint, so B - A is the unit direction.
+// The direction is that of this line rotated 90 degrees counter-clockwise,
+// so its B point is on the left side, see Side.
+// A zero length line returns a zero length line at the same point.
+func (l *Line) PerpendicularBisector() *Line {
+	m := l.Midpoint()
+
+	d := l.Distance()
+	if d == 0 {
+		return &Line{a: *m, b: *m}
+	}
+
+	// half of the unit direction
+	dx, dy := (l.b[0]-l.a[0])/d/2, (l.b[1]-l.a[1])/d/2
+
+	return &Line{
+		a: Point{m[0] + dy, m[1] - dx},
+		b: Point{m[0] - dy, m[1] + dx},
+	}
+}
+
 // GeoMidpoint returns the half-way point along a great circle path between the two points.
 // WARNING: untested
 func (l *Line) GeoMidpoint() *Point {
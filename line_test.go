@@ -166,6 +166,50 @@ func TestDirection(t *testing.T) {
 	}
 }
 
+func TestLineAngleTo(t *testing.T) {
+	l := NewLine(NewPoint(0, 0), NewPoint(1, 0))
+
+	lines := []*Line{
+		NewLine(NewPoint(5, 5), NewPoint(7, 5)),
+		NewLine(NewPoint(0, 0), NewPoint(0, 1)),
+		NewLine(NewPoint(0, 0), NewPoint(0, -1)),
+		NewLine(NewPoint(1, 0), NewPoint(0, 0)),
+		NewLine(NewPoint(0, 0), NewPoint(1, 1)),
+	}
+
+	answers := []float64{0, 0.5 * math.Pi, 0.5 * math.Pi, math.Pi, 0.25 * math.Pi}
+
+	for i, v := range answers {
+		if a := l.AngleTo(lines[i]); math.Abs(a-v) > epsilon {
+			t.Errorf("line, angle to expected %f, got %f", v, a)
+		}
+	}
+
+	if a := l.AngleTo(NewLine(NewPoint(1, 1), NewPoint(1, 1))); !math.IsNaN(a) {
+		t.Errorf("line, angle to zero length line should be NaN, got %f", a)
+	}
+}
+
+func TestLineIsParallel(t *testing.T) {
+	l := NewLine(NewPoint(0, 0), NewPoint(1, 0))
+
+	if !l.IsParallel(NewLine(NewPoint(0, 1), NewPoint(2, 1)), 0) {
+		t.Error("line, is parallel expected same direction to be parallel")
+	}
+
+	if !l.IsParallel(NewLine(NewPoint(2, 1), NewPoint(0, 1)), epsilon) {
+		t.Error("line, is parallel expected opposite direction to be parallel")
+	}
+
+	if l.IsParallel(NewLine(NewPoint(0, 0), NewPoint(1, 0.1)), 0.01) {
+		t.Error("line, is parallel expected angle outside tolerance to not be parallel")
+	}
+
+	if !l.IsParallel(NewLine(NewPoint(0, 0), NewPoint(1, 0.1)), 0.2) {
+		t.Error("line, is parallel expected angle within tolerance to be parallel")
+	}
+}
+
 func TestLineDistance(t *testing.T) {
 	l := NewLine(NewPoint(0, 0), NewPoint(3, 4))
 	if d := l.Distance(); d != 5 {
@@ -215,6 +259,20 @@ func TestLineInterpolate(t *testing.T) {
 	}
 }
 
+func TestLineInterpolateTo(t *testing.T) {
+	l := NewLine(NewPoint(0, 0), NewPoint(5, 10))
+
+	result := NewPoint(7, 7)
+	if p := l.InterpolateTo(.20, result); p != result || !p.Equals(NewPoint(1, 2)) {
+		t.Errorf("line, interpolateTo expected %v in the given point, got %v", NewPoint(1, 2), p)
+	}
+
+	pool := NewPointPool()
+	if p := l.InterpolateTo(.80, pool.Get()); !p.Equals(NewPoint(4, 8)) {
+		t.Errorf("line, interpolateTo with pool expected %v, got %v", NewPoint(4, 8), p)
+	}
+}
+
 func TestLineSide(t *testing.T) {
 	l := NewLine(NewPoint(0, 0), NewPoint(0, 10))
 
@@ -279,6 +337,90 @@ func TestLineIntersection(t *testing.T) {
 	}
 }
 
+func TestLineGeoIntersection(t *testing.T) {
+	equator := NewLine(NewPoint(-10, 0), NewPoint(10, 0))
+	meridian := NewLine(NewPoint(0, -10), NewPoint(0, 10))
+
+	if p := equator.GeoIntersection(meridian); p == nil || p.DistanceFrom(NewPoint(0, 0)) > epsilon {
+		t.Errorf("line, geoIntersection expected origin, got %v", p)
+	}
+
+	if p := NewLine(NewPoint(20, -10), NewPoint(20, 10)).GeoIntersection(equator); p != nil {
+		t.Errorf("line, geoIntersection should not intersect, got %v", p)
+	}
+
+	// the great circle bulges toward the pole, where the planar line does not
+	arc := NewLine(NewPoint(-80, 60), NewPoint(80, 60))
+	northMeridian := NewLine(NewPoint(0, 80), NewPoint(0, 89))
+
+	if p := arc.Intersection(northMeridian); p != nil {
+		t.Errorf("line, planar intersection should not intersect, got %v", p)
+	}
+
+	expected := NewPoint(0, rad2deg(math.Atan(math.Tan(deg2rad(60))/math.Cos(deg2rad(80)))))
+	if p := arc.GeoIntersection(northMeridian); p == nil || p.DistanceFrom(expected) > epsilon {
+		t.Errorf("line, geoIntersection expected %v, got %v", expected, p)
+	}
+
+	// same great circle
+	if p := equator.GeoIntersection(NewLine(NewPoint(0, 0), NewPoint(20, 0))); p != nil {
+		t.Errorf("line, geoIntersection on same great circle should be nil, got %v", p)
+	}
+
+	// across the antimeridian
+	l1 := NewLine(NewPoint(170, -10), NewPoint(-170, 10))
+	l2 := NewLine(NewPoint(170, 10), NewPoint(-170, -10))
+	if p := l1.GeoIntersection(l2); p == nil || math.Abs(math.Abs(p.Lng())-180) > epsilon || math.Abs(p.Lat()) > epsilon {
+		t.Errorf("line, geoIntersection across antimeridian, got %v", p)
+	}
+}
+
+func TestLineClipToBound(t *testing.T) {
+	bound := NewBound(0, 10, 0, 10)
+
+	type testCase struct {
+		line     *Line
+		expected *Line
+	}
+
+	tests := []testCase{
+		{NewLine(NewPoint(1, 1), NewPoint(5, 5)), NewLine(NewPoint(1, 1), NewPoint(5, 5))},
+		{NewLine(NewPoint(-5, 5), NewPoint(15, 5)), NewLine(NewPoint(0, 5), NewPoint(10, 5))},
+		{NewLine(NewPoint(5, 15), NewPoint(5, 5)), NewLine(NewPoint(5, 10), NewPoint(5, 5))},
+		{NewLine(NewPoint(-5, -5), NewPoint(15, 15)), NewLine(NewPoint(0, 0), NewPoint(10, 10))},
+		{NewLine(NewPoint(-5, 5), NewPoint(5, 15)), NewLine(NewPoint(0, 10), NewPoint(0, 10))},
+		{NewLine(NewPoint(-5, -5), NewPoint(-1, 20)), nil},
+		{NewLine(NewPoint(11, 0), NewPoint(11, 10)), nil},
+		{NewLine(NewPoint(-5, 6), NewPoint(6, 17)), nil},
+	}
+
+	for i, test := range tests {
+		clipped, ok := test.line.ClipToBound(bound)
+		if test.expected == nil {
+			if ok || clipped != nil {
+				t.Errorf("line, clip %d expected not visible, got %v", i, clipped)
+			}
+			continue
+		}
+
+		if !ok {
+			t.Errorf("line, clip %d expected visible", i)
+			continue
+		}
+
+		if clipped.A().DistanceFrom(test.expected.A()) > epsilon || clipped.B().DistanceFrom(test.expected.B()) > epsilon {
+			t.Errorf("line, clip %d expected %v, got %v", i, test.expected, clipped)
+		}
+	}
+
+	// original line not modified
+	l := NewLine(NewPoint(-5, 5), NewPoint(15, 5))
+	l.ClipToBound(bound)
+	if !l.A().Equals(NewPoint(-5, 5)) {
+		t.Errorf("line, clip should not modify the line, got %v", l)
+	}
+}
+
 func TestLineIntersects(t *testing.T) {
 	var answer bool
 	l := NewLine(NewPoint(0, 0), NewPoint(1, 1))
@@ -319,6 +461,45 @@ func TestLineMidpoint(t *testing.T) {
 	}
 }
 
+func TestLinePerpendicularBisector(t *testing.T) {
+	l := NewLine(NewPoint(0, 0), NewPoint(10, 0))
+
+	answer := NewLine(NewPoint(5, -0.5), NewPoint(5, 0.5))
+	if b := l.PerpendicularBisector(); !b.Equals(answer) {
+		t.Errorf("line, perpendicularBisector expected %v, got %v", answer, b)
+	}
+
+	l = NewLine(NewPoint(1, 1), NewPoint(3, 5))
+	b := l.PerpendicularBisector()
+
+	if !b.Midpoint().Equals(l.Midpoint()) {
+		t.Errorf("line, perpendicularBisector should share the midpoint, got %v", b.Midpoint())
+	}
+
+	if d := b.Distance(); math.Abs(d-1) > epsilon {
+		t.Errorf("line, perpendicularBisector expected unit length, got %f", d)
+	}
+
+	u := NewPoint(b.B()[0]-b.A()[0], b.B()[1]-b.A()[1])
+	v := NewPoint(l.B()[0]-l.A()[0], l.B()[1]-l.A()[1])
+	if d := u.Dot(v); math.Abs(d) > epsilon {
+		t.Errorf("line, perpendicularBisector should be perpendicular, got dot %f", d)
+	}
+
+	if s := l.Side(b.B()); s != -1 {
+		t.Errorf("line, perpendicularBisector b should be on the left, got %d", s)
+	}
+
+	if d := b.A().DistanceFrom(l.A()) - b.A().DistanceFrom(l.B()); math.Abs(d) > epsilon {
+		t.Errorf("line, perpendicularBisector should be equidistant, got %f", d)
+	}
+
+	l = NewLine(NewPoint(1, 1), NewPoint(1, 1))
+	if b := l.PerpendicularBisector(); !b.Equals(l) {
+		t.Errorf("line, perpendicularBisector of zero length line expected %v, got %v", l, b)
+	}
+}
+
 func TestLineBound(t *testing.T) {
 	var answer *Bound
 	a := NewPoint(1, 2)
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"math/rand"
+	"sort"
 )
 
 // Path represents a set of points to be thought of as a polyline.
@@ -29,6 +31,17 @@ func NewPathPreallocate(length, capacity int) *Path {
 	return p
 }
 
+// NewPathFromPoints creates a new path from the given points.
+// The points are copied into a backing slice of the exact size.
+func NewPathFromPoints(points ...*Point) *Path {
+	p := NewPathPreallocate(len(points), len(points))
+	for i, point := range points {
+		p.points[i] = *point
+	}
+
+	return p
+}
+
 // NewPathFromEncoding is the inverse of path.Encode. It takes a string encoding of a lat/lng path
 // and returns the actual path it represents. Factor defaults to 1.0e5,
 // the same used by Google for polyline encoding.
@@ -103,6 +116,34 @@ func NewPathFromYXData(data [][2]float64) *Path {
 	return p
 }
 
+// CoordOrder defines the order of the two coordinates of a point
+// when importing or exporting [2]float64 data.
+type CoordOrder int
+
+const (
+	// LngLat is [lng, lat] or [x, y] order, as used by GeoJSON.
+	LngLat CoordOrder = iota
+
+	// LatLng is [lat, lng] or [y, x] order, as used by many mapping APIs.
+	LatLng
+)
+
+// NewPathFromLineString creates a path from a slice of [2]float64 values
+// with the coordinates in the given order. Inverse of Path.ToLineString.
+func NewPathFromLineString(data [][2]float64, order CoordOrder) *Path {
+	if order == LatLng {
+		return NewPathFromYXData(data)
+	}
+
+	return NewPathFromXYData(data)
+}
+
+// NewPathFromLatLngs creates a path from a slice of coordinate pairs in the given order.
+// It is the same as NewPathFromLineString.
+func NewPathFromLatLngs(latlngs [][2]float64, order CoordOrder) *Path {
+	return NewPathFromLineString(latlngs, order)
+}
+
 // NewPathFromXYSlice creates a path from a slice of []float64 values.
 // The first two elements are taken to be horizontal and vertical components of each point respectively.
 // The rest of the elements of the slide are ignored. Nil slices are skipped.
@@ -146,6 +187,21 @@ func (p *Path) Points() []Point {
 	return p.points
 }
 
+// ToLineString returns the points of the path as a slice of [2]float64 values
+// with the coordinates in the given order. Inverse of NewPathFromLineString.
+func (p *Path) ToLineString(order CoordOrder) [][2]float64 {
+	data := make([][2]float64, len(p.points))
+	for i, point := range p.points {
+		if order == LatLng {
+			data[i] = [2]float64{point[1], point[0]}
+		} else {
+			data[i] = [2]float64(point)
+		}
+	}
+
+	return data
+}
+
 // Transform applies a given projection or inverse projection to all
 // the points in the path.
 func (p *Path) Transform(projector Projector) *Path {
@@ -156,6 +212,34 @@ func (p *Path) Transform(projector Projector) *Path {
 	return p
 }
 
+// SnapToGrid rounds every point of the path to the nearest multiple of cellSize.
+// Near-coincident points from different sources will become equal.
+// Modifies the path.
+func (p *Path) SnapToGrid(cellSize float64) *Path {
+	for i := range p.points {
+		p.points[i].SnapToGrid(cellSize)
+	}
+
+	return p
+}
+
+// Round rounds every point of the path to the given number of decimal places.
+// Six decimals, about 0.1 meters, is plenty for displaying lng/lat data and
+// removes floating point noise when encoding to JSON. Modifies the path.
+func (p *Path) Round(decimals int) *Path {
+	for i := range p.points {
+		p.points[i].Round(decimals)
+	}
+
+	return p
+}
+
+// Truncate reduces the precision of every point of the path to the given
+// number of decimal places, see Point.Truncate. Modifies the path.
+func (p *Path) Truncate(decimals int) *Path {
+	return p.Round(decimals)
+}
+
 // Resample converts the path into totalPoints-1 evenly spaced segments.
 func (p *Path) Resample(totalPoints int) *Path {
 	// degenerate case
@@ -242,6 +326,175 @@ func (p *Path) Resample(totalPoints int) *Path {
 	return p
 }
 
+// ResampleWithHeadings converts the path into segments of length interval,
+// plus a final shorter segment to the original end point. It also returns a slice,
+// parallel to the new points, of the direction of the path at each point.
+// The units are radians from the positive x-axis, the same as Line.Direction.
+// The heading at the final point is that of the last segment. Assumes a conformal projection.
+// Modifies the path. Panics if interval is not positive.
+func (p *Path) ResampleWithHeadings(interval float64) (*Path, []float64) {
+	if interval <= 0 {
+		panic(fmt.Sprintf("geo: resample interval must be positive, given %f", interval))
+	}
+
+	if len(p.points) == 0 {
+		return p, []float64{}
+	}
+
+	points := make([]Point, 0, len(p.points))
+	headings := make([]float64, 0, len(p.points))
+
+	heading := math.Inf(1)
+	distance := 0.0
+	next := 0.0
+
+	seg := &Line{}
+	for i := 0; i < len(p.points)-1; i++ {
+		seg.a = p.points[i]
+		seg.b = p.points[i+1]
+
+		length := seg.Distance()
+		if length == 0 {
+			continue
+		}
+
+		heading = seg.Direction()
+		for next < distance+length {
+			points = append(points, *seg.Interpolate((next - distance) / length))
+			headings = append(headings, heading)
+
+			next += interval
+		}
+
+		distance += length
+	}
+
+	// end stays the same and reuses the direction of the last segment
+	points = append(points, p.points[len(p.points)-1])
+	headings = append(headings, heading)
+
+	p.points = points
+	return p, headings
+}
+
+// ResampleKeepingVertices densifies the path by adding evenly spaced points to
+// every segment longer than maxSpacing. Unlike Resample all the original points are kept,
+// so sharp corners are preserved. Modifies the path. Panics if maxSpacing is not positive.
+func (p *Path) ResampleKeepingVertices(maxSpacing float64) *Path {
+	if maxSpacing <= 0 {
+		panic(fmt.Sprintf("geo: resample spacing must be positive, given %f", maxSpacing))
+	}
+
+	if len(p.points) <= 1 {
+		return p
+	}
+
+	points := make([]Point, 0, len(p.points))
+
+	seg := &Line{}
+	for i := 0; i < len(p.points)-1; i++ {
+		seg.a = p.points[i]
+		seg.b = p.points[i+1]
+
+		points = append(points, seg.a)
+
+		count := int(math.Ceil(seg.Distance() / maxSpacing))
+		for j := 1; j < count; j++ {
+			points = append(points, *seg.Interpolate(float64(j) / float64(count)))
+		}
+	}
+
+	p.points = append(points, p.points[len(p.points)-1])
+	return p
+}
+
+// GeoDensify adds points along the great circle of every segment longer than maxSegmentMeters
+// so that no segment is longer than that distance. This is the geo aware version of
+// ResampleKeepingVertices and is useful before projecting long lng/lat segments, such as
+// flight paths, so they curve correctly. New longitudes are in [-180, 180], so segments crossing
+// the anti-meridian will wrap. Modifies the path. Panics if maxSegmentMeters is not positive.
+func (p *Path) GeoDensify(maxSegmentMeters float64) *Path {
+	if maxSegmentMeters <= 0 {
+		panic(fmt.Sprintf("geo: densify segment length must be positive, given %f", maxSegmentMeters))
+	}
+
+	if len(p.points) <= 1 {
+		return p
+	}
+
+	points := make([]Point, 0, len(p.points))
+	for i := 0; i < len(p.points)-1; i++ {
+		points = append(points, p.points[i])
+
+		a := geoUnitVector(&p.points[i])
+		b := geoUnitVector(&p.points[i+1])
+
+		c := vectorCross(a, b)
+		angle := math.Atan2(math.Sqrt(vectorDot(c, c)), vectorDot(a, b))
+
+		count := int(math.Ceil(angle * EarthRadius / maxSegmentMeters))
+		for j := 1; j < count; j++ {
+			points = append(points, geoSlerp(a, b, angle, float64(j)/float64(count)))
+		}
+	}
+
+	p.points = append(points, p.points[len(p.points)-1])
+	return p
+}
+
+// SamplePoints returns n random points along the path, distributed uniformly by distance,
+// so longer segments get proportionally more points. Uses the given random source,
+// or the default source of math/rand if nil. Returns an empty slice for empty paths
+// and copies of the first point if the path has zero length.
+func (p *Path) SamplePoints(n int, rng *rand.Rand) []*Point {
+	points := make([]*Point, 0, n)
+	if len(p.points) == 0 || n <= 0 {
+		return points
+	}
+
+	random := rand.Float64
+	if rng != nil {
+		random = rng.Float64
+	}
+
+	// cumulative distance at the end of each segment
+	distances := make([]float64, len(p.points)-1)
+	total := 0.0
+	for i := range distances {
+		total += p.points[i].DistanceFrom(&p.points[i+1])
+		distances[i] = total
+	}
+
+	if total == 0 {
+		for i := 0; i < n; i++ {
+			points = append(points, p.points[0].Clone())
+		}
+
+		return points
+	}
+
+	seg := &Line{}
+	for i := 0; i < n; i++ {
+		d := random() * total
+
+		index := sort.SearchFloat64s(distances, d)
+		if index == len(distances) {
+			index-- // round off
+		}
+
+		start := 0.0
+		if index > 0 {
+			start = distances[index-1]
+		}
+
+		seg.a = p.points[index]
+		seg.b = p.points[index+1]
+		points = append(points, seg.Interpolate((d-start)/(distances[index]-start)))
+	}
+
+	return points
+}
+
 // Decode is deprecated, use NewPathFromEncoding
 func Decode(encoded string, factor ...int) *Path {
 	return NewPathFromEncoding(encoded, factor...)
@@ -250,54 +503,154 @@ func Decode(encoded string, factor ...int) *Path {
 // Encode converts the path to a string using the Google Maps Polyline Encoding method.
 // Factor defaults to 1.0e5, the same used by Google for polyline encoding.
 func (p *Path) Encode(factor ...int) string {
-	f := 1.0e5
+	var result bytes.Buffer
+	p.EncodeTo(&result, factor...)
+
+	return result.String()
+}
+
+// CanonicalEncode encodes the path or its reverse, whichever results in the
+// lexicographically smaller string, so a path and its reverse encode the same.
+// Useful as a cache key for undirected geometry. The returned bool is true if
+// the reversed path was encoded. Factor defaults to 1.0e5.
+func (p *Path) CanonicalEncode(factor ...int) (string, bool) {
+	reversed := &Path{points: make([]Point, len(p.points))}
+	for i, point := range p.points {
+		reversed.points[len(p.points)-1-i] = point
+	}
+
+	forward := p.Encode(factor...)
+	backward := reversed.Encode(factor...)
+
+	if backward < forward {
+		return backward, true
+	}
+
+	return forward, false
+}
+
+// EncodeTo writes the Google Maps Polyline Encoding of the path to the writer
+// without building the whole string in memory. Returns the number of bytes written
+// and the first write error, if any. Factor defaults to 1.0e5.
+func (p *Path) EncodeTo(w io.Writer, factor ...int) (int, error) {
+	return p.encodeTo(w, encodingFactor(factor), true, false)
+}
+
+// EncodeCompact is the same as Encode but drops consecutive points that are
+// the same after rounding to the factor. These would be encoded as zero deltas and
+// just waste bytes. Decoding the result gives the path without the duplicates.
+func (p *Path) EncodeCompact(factor ...int) string {
+	var result bytes.Buffer
+	p.encodeTo(&result, encodingFactor(factor), true, true)
+
+	return result.String()
+}
+
+// A PolylineCodec encodes and decodes paths using the Google Maps Polyline Encoding
+// with a specific precision and rounding, for example to match a provider's exact output.
+// The zero value has a factor of 1.0e5 and truncates, DefaultPolylineCodec matches Path.Encode.
+type PolylineCodec struct {
+	Factor int  // defaults to 1.0e5 if 0, use 1.0e6 for 6 digits of precision
+	Round  bool // round to the nearest value, if false truncate toward zero
+}
+
+// DefaultPolylineCodec has the same behavior as Path.Encode and NewPathFromEncoding,
+// a factor of 1.0e5 with the coordinates rounded, as done by Google.
+var DefaultPolylineCodec = PolylineCodec{Factor: 1.0e5, Round: true}
+
+// Encode returns the polyline encoding of the path.
+func (c PolylineCodec) Encode(p *Path) string {
+	var result bytes.Buffer
+	p.encodeTo(&result, c.factor(), c.Round, false)
+
+	return result.String()
+}
+
+// EncodeTo writes the polyline encoding of the path to the writer, see Path.EncodeTo.
+func (c PolylineCodec) EncodeTo(w io.Writer, p *Path) (int, error) {
+	return p.encodeTo(w, c.factor(), c.Round, false)
+}
+
+// Decode returns the path represented by the polyline encoding.
+func (c PolylineCodec) Decode(encoded string) *Path {
+	return NewPathFromEncoding(encoded, int(c.factor()))
+}
+
+func (c PolylineCodec) factor() float64 {
+	if c.Factor == 0 {
+		return 1.0e5
+	}
+
+	return float64(c.Factor)
+}
+
+func encodingFactor(factor []int) float64 {
 	if len(factor) != 0 {
-		f = float64(factor[0])
+		return float64(factor[0])
 	}
 
+	return 1.0e5
+}
+
+func (p *Path) encodeTo(w io.Writer, f float64, round, compact bool) (int, error) {
 	var pLat int
 	var pLng int
 
-	var result bytes.Buffer
+	var total int
+	buf := make([]byte, 0, 16)
 
-	for _, p := range p.points {
-		lat5 := int(math.Floor(p.Lat()*f + 0.5))
-		lng5 := int(math.Floor(p.Lng()*f + 0.5))
+	for i, p := range p.points {
+		lat5 := quantize(p.Lat()*f, round)
+		lng5 := quantize(p.Lng()*f, round)
 
 		deltaLat := lat5 - pLat
 		deltaLng := lng5 - pLng
 
+		if compact && i != 0 && deltaLat == 0 && deltaLng == 0 {
+			continue
+		}
+
 		pLat = lat5
 		pLng = lng5
 
-		result.WriteString(encodeSignedNumber(deltaLat))
-		result.WriteString(encodeSignedNumber(deltaLng))
+		buf = appendSignedNumber(buf[:0], deltaLat)
+		buf = appendSignedNumber(buf, deltaLng)
+
+		n, err := w.Write(buf)
+		total += n
+		if err != nil {
+			return total, err
+		}
 	}
 
-	return result.String()
+	return total, nil
 }
 
-func encodeSignedNumber(num int) string {
+func quantize(x float64, round bool) int {
+	if round {
+		return int(math.Floor(x + 0.5))
+	}
+
+	return int(math.Trunc(x))
+}
+
+func appendSignedNumber(buf []byte, num int) []byte {
 	shiftedNum := num << 1
 
 	if num < 0 {
 		shiftedNum = ^shiftedNum
 	}
 
-	return encodeNumber(shiftedNum)
+	return appendNumber(buf, shiftedNum)
 }
 
-func encodeNumber(num int) string {
-	result := ""
-
+func appendNumber(buf []byte, num int) []byte {
 	for num >= 0x20 {
-		result += string((0x20 | (num & 0x1f)) + 63)
+		buf = append(buf, byte((0x20|(num&0x1f))+63))
 		num >>= 5
 	}
 
-	result += string(num + 63)
-
-	return result
+	return append(buf, byte(num+63))
 }
 
 // Distance computes the total distance in the units of the points.
@@ -325,6 +678,83 @@ func (p *Path) GeoDistance(haversine ...bool) float64 {
 	return sum
 }
 
+// VincentyTotalDistance returns the length of the lng/lat path, in meters, summing the
+// ellipsoidal distance of every segment, see Point.VincentyDistanceFrom. If a segment fails
+// to converge the sum of the segments before it is returned with ErrVincentyNoConvergence.
+func (p *Path) VincentyTotalDistance() (float64, error) {
+	sum := 0.0
+
+	loopTo := len(p.points) - 1
+	for i := 0; i < loopTo; i++ {
+		d, err := p.points[i].VincentyDistanceFrom(&p.points[i+1])
+		if err != nil {
+			return sum, err
+		}
+
+		sum += d
+	}
+
+	return sum, nil
+}
+
+// GeoArea returns the area, in square meters, enclosed by the path treated
+// as a closed ring on the sphere. Assumes lng/lat points. The path is implicitly closed
+// and the result is positive regardless of winding order. Based on "Some Algorithms
+// for Polygons on a Sphere" by Chamberlain and Duquette. An optional sphere radius,
+// in meters, can be given to use instead of EarthRadius.
+func (p *Path) GeoArea(radius ...float64) float64 {
+	if len(p.points) < 3 {
+		return 0
+	}
+
+	r := EarthRadius
+	if len(radius) != 0 {
+		r = radius[0]
+	}
+
+	sum := 0.0
+	for i := range p.points {
+		a := &p.points[i]
+		b := &p.points[(i+1)%len(p.points)]
+
+		sum += deg2rad(b.Lng()-a.Lng()) * (2 + math.Sin(deg2rad(a.Lat())) + math.Sin(deg2rad(b.Lat())))
+	}
+
+	return math.Abs(sum * r * r / 2)
+}
+
+// Sinuosity returns the distance along the path divided by the straight line distance
+// between its endpoints. It is 1 for a straight path and larger the more winding the path is.
+// Returns +Inf for loops, where the endpoints coincide, and 1 for paths with no length.
+func (p *Path) Sinuosity() float64 {
+	if len(p.points) < 2 {
+		return 1
+	}
+
+	return sinuosity(p.Distance(), p.points[0].DistanceFrom(&p.points[len(p.points)-1]))
+}
+
+// GeoSinuosity is the same as Sinuosity but uses geo distances, assuming lng/lat points.
+func (p *Path) GeoSinuosity(haversine ...bool) float64 {
+	if len(p.points) < 2 {
+		return 1
+	}
+
+	return sinuosity(p.GeoDistance(haversine...), p.points[0].GeoDistanceFrom(&p.points[len(p.points)-1], haversine...))
+}
+
+func sinuosity(total, straight float64) float64 {
+	if total == 0 {
+		return 1
+	}
+
+	if straight == 0 {
+		return math.Inf(1)
+	}
+
+	return total / straight
+}
+
 // DistanceFrom computes an O(n) distance from the path. Loops over every
 // subline to find the minimum distance.
 func (p *Path) DistanceFrom(point *Point) float64 {
@@ -335,18 +765,254 @@ func (p *Path) DistanceFrom(point *Point) float64 {
 // Loops over every subline to find the minimum distance.
 func (p *Path) SquaredDistanceFrom(point *Point) float64 {
 	dist := math.Inf(1)
-
-	l := &Line{}
-	loopTo := len(p.points) - 1
-	for i := 0; i < loopTo; i++ {
-		l.a = p.points[i]
-		l.b = p.points[i+1]
+	p.EachSegment(func(i int, l *Line) {
 		dist = math.Min(l.SquaredDistanceFrom(point), dist)
-	}
+	})
 
 	return dist
 }
 
+// DistanceFromPath computes the minimum distance between the two paths,
+// i.e. the distance at their closest approach. Returns 0 if the paths intersect.
+// Loops over every pair of sublines, O(n*m).
+func (p *Path) DistanceFromPath(path *Path) float64 {
+	dist := math.Inf(1)
+
+	l1 := &Line{}
+	l2 := &Line{}
+	for i := 0; i < len(p.points)-1; i++ {
+		l1.a = p.points[i]
+		l1.b = p.points[i+1]
+
+		for j := 0; j < len(path.points)-1; j++ {
+			l2.a = path.points[j]
+			l2.b = path.points[j+1]
+
+			if l1.Intersects(l2) {
+				return 0
+			}
+
+			// non intersecting segments are closest at one of the endpoints
+			dist = math.Min(dist, l1.SquaredDistanceFrom(&l2.a))
+			dist = math.Min(dist, l1.SquaredDistanceFrom(&l2.b))
+			dist = math.Min(dist, l2.SquaredDistanceFrom(&l1.a))
+			dist = math.Min(dist, l2.SquaredDistanceFrom(&l1.b))
+		}
+	}
+
+	return math.Sqrt(dist)
+}
+
+// AlignPaths computes a vertex correspondence between the two paths using
+// dynamic time warping. Returns the index pairs [i, j], ordered from the start
+// to the end of the paths, matching a.GetAt(i) to b.GetAt(j) such that the sum of
+// the distances between matched points is minimized. Every point is matched at least once.
+// Uses O(n*m) time and memory. Returns an empty slice if either path is empty.
+func AlignPaths(a, b *Path) [][2]int {
+	n := len(a.points)
+	m := len(b.points)
+
+	if n == 0 || m == 0 {
+		return [][2]int{}
+	}
+
+	// cost[i*m+j] is the minimum total distance aligning a[:i+1] with b[:j+1]
+	cost := make([]float64, n*m)
+	for i := 0; i < n; i++ {
+		for j := 0; j < m; j++ {
+			d := a.points[i].DistanceFrom(&b.points[j])
+
+			switch {
+			case i == 0 && j == 0:
+				cost[0] = d
+			case i == 0:
+				cost[j] = d + cost[j-1]
+			case j == 0:
+				cost[i*m] = d + cost[(i-1)*m]
+			default:
+				cost[i*m+j] = d + math.Min(cost[(i-1)*m+j-1], math.Min(cost[(i-1)*m+j], cost[i*m+j-1]))
+			}
+		}
+	}
+
+	// backtrack from the end to find the alignment
+	pairs := make([][2]int, 0, n+m)
+
+	i, j := n-1, m-1
+	for {
+		pairs = append(pairs, [2]int{i, j})
+		if i == 0 && j == 0 {
+			break
+		}
+
+		switch {
+		case i == 0:
+			j--
+		case j == 0:
+			i--
+		default:
+			diag := cost[(i-1)*m+j-1]
+			up := cost[(i-1)*m+j]
+			left := cost[i*m+j-1]
+
+			if diag <= up && diag <= left {
+				i--
+				j--
+			} else if up <= left {
+				i--
+			} else {
+				j--
+			}
+		}
+	}
+
+	// reverse so the pairs go from start to end
+	for l, r := 0, len(pairs)-1; l < r; l, r = l+1, r-1 {
+		pairs[l], pairs[r] = pairs[r], pairs[l]
+	}
+
+	return pairs
+}
+
+// MergeOverlapping fuses paths that overlap to within the tolerance into a single
+// representative geometry. Paths are processed in order and the first path to cover
+// an area is used as the representative. Segments of later paths that are not within
+// tolerance of the previous results along their whole length, checked at points spaced
+// by the tolerance, are kept as new paths. The direction of the input is preserved.
+// This is a simple approach with O(n^2) point to path comparisons.
+// Returns new paths, the inputs are not modified.
+func MergeOverlapping(paths []*Path, tolerance float64) []*Path {
+	var result []*Path
+
+	for _, path := range paths {
+		if len(path.points) < 2 {
+			continue
+		}
+
+		covered := make([]bool, len(path.points)-1)
+		for i := range covered {
+			covered[i] = segmentCovered(&path.points[i], &path.points[i+1], result, tolerance)
+		}
+
+		var pieces []*Path
+		for start := 0; start < len(covered); start++ {
+			if covered[start] {
+				continue
+			}
+
+			end := start
+			for end+1 < len(covered) && !covered[end+1] {
+				end++
+			}
+
+			piece := NewPathPreallocate(0, end-start+2)
+			piece.points = append(piece.points, path.points[start:end+2]...)
+			pieces = append(pieces, piece)
+
+			start = end
+		}
+
+		result = append(result, pieces...)
+	}
+
+	return result
+}
+
+// segmentCovered checks if the segment from a to b is within tolerance of the paths
+// at both ends and at points spaced at most tolerance apart in between.
+func segmentCovered(a, b *Point, paths []*Path, tolerance float64) bool {
+	steps := 1
+	if tolerance > 0 {
+		steps = int(math.Ceil(a.DistanceFrom(b) / tolerance))
+	}
+
+	if steps < 1 {
+		steps = 1
+	}
+
+	l := NewLine(a, b)
+	for i := 0; i <= steps; i++ {
+		p := l.Interpolate(float64(i) / float64(steps))
+
+		near := false
+		for _, path := range paths {
+			if path.DistanceFrom(p) <= tolerance {
+				near = true
+				break
+			}
+		}
+
+		if !near {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SideOf returns which side of the path the point is on relative to the direction
+// of travel along the path. Uses the segment nearest the point and follows the same
+// convention as Line.Side: -1 if the point is to the left, 1 if to the right
+// and 0 if on the segment's line. Returns 0 for paths with less than 2 points.
+func (p *Path) SideOf(point *Point) int {
+	if len(p.points) < 2 {
+		return 0
+	}
+
+	minDistance := math.Inf(1)
+	side := 0
+
+	p.EachSegment(func(i int, seg *Line) {
+		if d := seg.SquaredDistanceFrom(point); d < minDistance {
+			minDistance = d
+			side = seg.Side(point)
+		}
+	})
+
+	return side
+}
+
+// ClosestPointWithin returns the point on the path closest to the given point,
+// and true, if it is within maxDist. Returns nil and false otherwise.
+// Segments whose bounding box is further than maxDist away are skipped without
+// computing the exact distance, making this fast when most queries are far from the path.
+func (p *Path) ClosestPointWithin(point *Point, maxDist float64) (*Point, bool) {
+	minDistance := maxDist * maxDist
+	var closest *Point
+
+	seg := &Line{}
+	for i := 0; i < len(p.points)-1; i++ {
+		a := &p.points[i]
+		b := &p.points[i+1]
+
+		// quick rejection using the bound of the segment
+		if point[0] < math.Min(a[0], b[0])-maxDist || point[0] > math.Max(a[0], b[0])+maxDist ||
+			point[1] < math.Min(a[1], b[1])-maxDist || point[1] > math.Max(a[1], b[1])+maxDist {
+			continue
+		}
+
+		seg.a = *a
+		seg.b = *b
+
+		if d := seg.SquaredDistanceFrom(point); d <= minDistance {
+			minDistance = d
+
+			percent := 0.0
+			if !seg.a.Equals(&seg.b) {
+				percent = math.Max(0, math.Min(1, seg.Project(point)))
+			}
+			closest = seg.Interpolate(percent)
+		}
+	}
+
+	// single point paths
+	if len(p.points) == 1 && p.points[0].SquaredDistanceFrom(point) <= minDistance {
+		closest = p.points[0].Clone()
+	}
+
+	return closest, closest != nil
+}
+
 // DirectionAt computes the direction of the path at the given index.
 // Uses the line between the two surrounding points to get the direction,
 // or just the first two, or last two if at the start or end, respectively.
@@ -355,24 +1021,76 @@ func (p *Path) SquaredDistanceFrom(point *Point) float64 {
 // Returns INF for single point paths.
 func (p *Path) DirectionAt(index int) float64 {
 	if index >= len(p.points) || index < 0 {
-		panic(fmt.Sprintf("geo: direction at index out of range, requested: %d, length: %d", index, len(p.points)))
+		panic(&IndexError{Op: "direction at", Index: index, Length: len(p.points)})
 	}
 
 	if len(p.points) == 1 {
 		return math.Inf(1)
 	}
 
-	var diff *Point
+	// avoid allocating a temporary point, this is called a lot
+	var from, to *Point
 	if index == 0 {
-		diff = p.GetAt(1).Clone().Subtract(p.GetAt(0))
-	} else if index >= p.Length()-1 {
-		length := p.Length()
-		diff = p.GetAt(length - 1).Clone().Subtract(p.GetAt(length - 2))
+		from, to = &p.points[0], &p.points[1]
+	} else if index >= len(p.points)-1 {
+		from, to = &p.points[len(p.points)-2], &p.points[len(p.points)-1]
 	} else {
-		diff = p.GetAt(index + 1).Clone().Subtract(p.GetAt(index - 1))
+		from, to = &p.points[index-1], &p.points[index+1]
+	}
+
+	return math.Atan2(to[1]-from[1], to[0]-from[0])
+}
+
+// A Maneuver is a significant turn along a path, see Path.Maneuvers.
+type Maneuver struct {
+	Index    int     // index of the point in the path where the turn happens
+	Location Point   // the point where the turn happens
+	Angle    float64 // change in bearing, in degrees (-180, 180], positive to the right
+	Side     int     // -1 for a left turn and 1 for right, same convention as Line.Side
+}
+
+// Maneuvers returns the turns along the lng/lat path where the bearing changes by
+// at least minTurnDegrees, for example to build simple turn by turn directions.
+// Consecutive duplicate points are skipped when computing the bearings.
+func (p *Path) Maneuvers(minTurnDegrees float64) []Maneuver {
+	// indexes of the points that differ from the previous
+	var distinct []int
+	for i := range p.points {
+		if i == 0 || !p.points[i].Equals(&p.points[i-1]) {
+			distinct = append(distinct, i)
+		}
+	}
+
+	var maneuvers []Maneuver
+	for k := 1; k < len(distinct)-1; k++ {
+		prev, curr, next := &p.points[distinct[k-1]], &p.points[distinct[k]], &p.points[distinct[k+1]]
+
+		angle := curr.BearingTo(next) - prev.BearingTo(curr)
+		for angle > 180 {
+			angle -= 360
+		}
+		for angle <= -180 {
+			angle += 360
+		}
+
+		if math.Abs(angle) < minTurnDegrees || angle == 0 {
+			continue
+		}
+
+		side := 1
+		if angle < 0 {
+			side = -1
+		}
+
+		maneuvers = append(maneuvers, Maneuver{
+			Index:    distinct[k],
+			Location: *curr,
+			Angle:    angle,
+			Side:     side,
+		})
 	}
 
-	return math.Atan2(diff.Y(), diff.X())
+	return maneuvers
 }
 
 // Measure computes the distance along this path to the point nearest the given point.
@@ -381,17 +1099,14 @@ func (p *Path) Measure(point *Point) float64 {
 	measure := math.Inf(-1)
 	sum := 0.0
 
-	seg := &Line{}
-	for i := 0; i < len(p.points)-1; i++ {
-		seg.a = p.points[i]
-		seg.b = p.points[i+1]
+	p.EachSegment(func(i int, seg *Line) {
 		distanceToLine := seg.SquaredDistanceFrom(point)
 		if distanceToLine < minDistance {
 			minDistance = distanceToLine
 			measure = sum + seg.Measure(point)
 		}
 		sum += seg.Distance()
-	}
+	})
 	return measure
 }
 
@@ -401,6 +1116,40 @@ func (p *Path) Project(point *Point) float64 {
 	return p.Measure(point) / p.Distance()
 }
 
+// LocatePoint finds the segment of the path nearest the given point. Returns the
+// normalized distance along that segment, in [0,1], the index of the segment,
+// i.e. the segment from point i to i+1, and the projection of the point onto the segment.
+// Useful for inserting a new point at segmentIndex+1.
+// Returns -1 and a nil point for paths with less than 2 points.
+func (p *Path) LocatePoint(point *Point) (fraction float64, segmentIndex int, projected *Point) {
+	if len(p.points) < 2 {
+		return 0, -1, nil
+	}
+
+	minDistance := math.Inf(1)
+
+	seg := &Line{}
+	for i := 0; i < len(p.points)-1; i++ {
+		seg.a = p.points[i]
+		seg.b = p.points[i+1]
+
+		if d := seg.SquaredDistanceFrom(point); d < minDistance {
+			minDistance = d
+			segmentIndex = i
+
+			fraction = 0
+			if !seg.a.Equals(&seg.b) {
+				fraction = math.Max(0, math.Min(1, seg.Project(point)))
+			}
+		}
+	}
+
+	seg.a = p.points[segmentIndex]
+	seg.b = p.points[segmentIndex+1]
+
+	return fraction, segmentIndex, seg.Interpolate(fraction)
+}
+
 // Intersection calls IntersectionPath or IntersectionLine depending on the
 // type of the provided geometry.
 // TODO: have this receive an Intersectable interface.
@@ -432,18 +1181,14 @@ func (p *Path) IntersectionPath(path *Path) ([]*Point, [][2]int) {
 	var points []*Point
 	var indexes [][2]int
 
-	for i := 0; i < len(p.points)-1; i++ {
-		pLine := NewLine(&p.points[i], &p.points[i+1])
-
-		for j := 0; j < len(path.points)-1; j++ {
-			pathLine := NewLine(&path.points[j], &path.points[j+1])
-
+	p.EachSegment(func(i int, pLine *Line) {
+		path.EachSegment(func(j int, pathLine *Line) {
 			if point := pLine.Intersection(pathLine); point != nil {
 				points = append(points, point)
 				indexes = append(indexes, [2]int{i, j})
 			}
-		}
-	}
+		})
+	})
 
 	return points, indexes
 }
@@ -455,17 +1200,34 @@ func (p *Path) IntersectionLine(line *Line) ([]*Point, [][2]int) {
 	var points []*Point
 	var indexes [][2]int
 
-	for i := 0; i < len(p.points)-1; i++ {
-		pTest := NewLine(&p.points[i], &p.points[i+1])
+	p.EachSegment(func(i int, pTest *Line) {
 		if point := pTest.Intersection(line); point != nil {
 			points = append(points, point)
 			indexes = append(indexes, [2]int{i, 0})
 		}
-	}
+	})
 
 	return points, indexes
 }
 
+// GeoIntersections returns the intersections of the two paths with the segments
+// treated as great circle arcs, assuming lng/lat points. Use this instead of IntersectionPath
+// for long segments where the planar intersection can be far off or missed entirely.
+// The slice will be empty if there is no intersection.
+func (p *Path) GeoIntersections(path *Path) []*Point {
+	var points []*Point
+
+	p.EachSegment(func(i int, pLine *Line) {
+		path.EachSegment(func(j int, pathLine *Line) {
+			if point := pLine.GeoIntersection(pathLine); point != nil {
+				points = append(points, point)
+			}
+		})
+	})
+
+	return points
+}
+
 // Intersects can take a line or a path to determine if there is an intersection.
 // TODO: I would love this to accept an intersecter interface.
 func (p *Path) Intersects(geometry interface{}) bool {
@@ -517,10 +1279,24 @@ func (p *Path) IntersectsLine(line *Line) bool {
 	return false
 }
 
+// Contains checks if the point is inside the path treated as a closed ring,
+// using the even-odd ray casting rule. Open paths are implicitly closed.
+// Points on the boundary may be inside or outside, but the result is consistent,
+// for example a point on the edge shared by two adjacent rings is inside exactly one of them.
+func (p *Path) Contains(point *Point) bool {
+	if len(p.points) < 3 {
+		return false
+	}
+
+	return ringContains(p.points, point)
+}
+
 // Bound returns a bound around the path. Simply uses rectangular coordinates.
+// Empty paths return the inverted bound from NewEmptyBound, which is Empty,
+// contains no points and is ignored by Union and Extend.
 func (p *Path) Bound() *Bound {
 	if len(p.points) == 0 {
-		return NewBound(0, 0, 0, 0)
+		return NewEmptyBound()
 	}
 
 	minX := math.Inf(1)
@@ -540,11 +1316,23 @@ func (p *Path) Bound() *Bound {
 	return NewBound(maxX, minX, maxY, minY)
 }
 
+// GeoBound returns the bound around the lng/lat path along with
+// its approximate width and height in meters, see Bound.GeoWidth and Bound.GeoHeight.
+// Empty paths return the empty bound, see Bound, with zero width and height.
+func (p *Path) GeoBound(haversine ...bool) (*Bound, float64, float64) {
+	b := p.Bound()
+	if len(p.points) == 0 {
+		return b, 0, 0
+	}
+
+	return b, b.GeoWidth(haversine...), b.GeoHeight()
+}
+
 // SetAt updates a position at i along the path.
-// Panics if index is out of range.
+// Panics with an *IndexError if index is out of range.
 func (p *Path) SetAt(index int, point *Point) *Path {
 	if index >= len(p.points) || index < 0 {
-		panic(fmt.Sprintf("geo: set index out of range, requested: %d, length: %d", index, len(p.points)))
+		panic(&IndexError{Op: "set", Index: index, Length: len(p.points)})
 	}
 	p.points[index] = *point
 	return p
@@ -561,11 +1349,34 @@ func (p *Path) GetAt(i int) *Point {
 	return &p.points[i]
 }
 
+// EachSegment calls f with every segment of the path, in order, where segment i is
+// the line from point i to point i+1. The same line is reused for every call,
+// to avoid allocating, so it must be cloned if kept. Modifying it does not change the path.
+func (p *Path) EachSegment(f func(i int, l *Line)) {
+	l := &Line{}
+	for i := 0; i < len(p.points)-1; i++ {
+		l.a = p.points[i]
+		l.b = p.points[i+1]
+
+		f(i, l)
+	}
+}
+
+// SegmentAt returns a copy of the segment from point i to point i+1.
+// Returns nil if i is out of range or the last index.
+func (p *Path) SegmentAt(i int) *Line {
+	if i >= len(p.points)-1 || i < 0 {
+		return nil
+	}
+
+	return &Line{p.points[i], p.points[i+1]}
+}
+
 // InsertAt inserts a Point at i along the path.
-// Panics if index is out of range.
+// Panics with an *IndexError if index is out of range.
 func (p *Path) InsertAt(index int, point *Point) *Path {
 	if index > len(p.points) || index < 0 {
-		panic(fmt.Sprintf("geo: insert index out of range, requested: %d, length: %d", index, len(p.points)))
+		panic(&IndexError{Op: "insert", Index: index, Length: len(p.points)})
 	}
 
 	if index == len(p.points) {
@@ -581,16 +1392,188 @@ func (p *Path) InsertAt(index int, point *Point) *Path {
 }
 
 // RemoveAt removes a Point at i along the path.
-// Panics if index is out of range.
+// Panics with an *IndexError if index is out of range.
 func (p *Path) RemoveAt(index int) *Path {
 	if index >= len(p.points) || index < 0 {
-		panic(fmt.Sprintf("geo: remove index out of range, requested: %d, length: %d", index, len(p.points)))
+		panic(&IndexError{Op: "remove", Index: index, Length: len(p.points)})
 	}
 
 	p.points = append(p.points[:index], p.points[index+1:]...)
 	return p
 }
 
+// RemoveRange removes the Points in [start, end) along the path in one shift.
+// Panics with an *IndexError, with the offending index, if the range is out of range or start > end.
+func (p *Path) RemoveRange(start, end int) *Path {
+	if start < 0 || start > end {
+		panic(&IndexError{Op: "remove range", Index: start, Length: len(p.points)})
+	}
+
+	if end > len(p.points) {
+		panic(&IndexError{Op: "remove range", Index: end, Length: len(p.points)})
+	}
+
+	p.points = append(p.points[:start], p.points[end:]...)
+	return p
+}
+
+// ThinBySpacing removes points closer than minDistance to the previous kept point,
+// bounding the density of the path, e.g. for display. The first and last points are always
+// kept; if the last point is too close to the previous kept point, that point is replaced.
+// Modifies the path.
+func (p *Path) ThinBySpacing(minDistance float64) *Path {
+	return p.thinBySpacing(minDistance, func(a, b *Point) float64 {
+		return a.DistanceFrom(b)
+	})
+}
+
+// GeoThinBySpacing is the same as ThinBySpacing but uses the geo distance,
+// in meters, between the points. Assumes lng/lat points. Modifies the path.
+func (p *Path) GeoThinBySpacing(minMeters float64, haversine ...bool) *Path {
+	yesgeo := yesHaversine(haversine)
+	return p.thinBySpacing(minMeters, func(a, b *Point) float64 {
+		return a.GeoDistanceFrom(b, yesgeo)
+	})
+}
+
+func (p *Path) thinBySpacing(min float64, distance func(a, b *Point) float64) *Path {
+	if len(p.points) <= 2 {
+		return p
+	}
+
+	kept := 1
+	for i := 1; i < len(p.points)-1; i++ {
+		if distance(&p.points[kept-1], &p.points[i]) < min {
+			continue
+		}
+
+		p.points[kept] = p.points[i]
+		kept++
+	}
+
+	last := p.points[len(p.points)-1]
+	if kept > 1 && distance(&p.points[kept-1], &last) < min {
+		kept--
+	}
+
+	p.points[kept] = last
+	p.points = p.points[:kept+1]
+
+	return p
+}
+
+// RemoveCollinear removes vertices within epsilon of the segment between the previous
+// kept vertex and the next vertex, such as the extra points along a straight line.
+// An epsilon of 0 only removes exactly collinear points, so the shape is unchanged.
+// Vertices where the path reverses direction are kept. Modifies the path.
+func (p *Path) RemoveCollinear(epsilon float64) *Path {
+	if len(p.points) <= 2 {
+		return p
+	}
+
+	seg := &Line{}
+
+	kept := 1
+	for i := 1; i < len(p.points)-1; i++ {
+		seg.a = p.points[kept-1]
+		seg.b = p.points[i+1]
+
+		if seg.DistanceFrom(&p.points[i]) <= epsilon {
+			continue
+		}
+
+		p.points[kept] = p.points[i]
+		kept++
+	}
+
+	p.points[kept] = p.points[len(p.points)-1]
+	p.points = p.points[:kept+1]
+
+	return p
+}
+
+// outlierRelocationPoints is the number of consecutive removed points, each within
+// the max step of the previous, after which RemoveOutliers accepts them as the new track.
+const outlierRelocationPoints = 3
+
+// RemoveOutliers removes points more than maxStepMeters from the previous
+// kept point, such as GPS jumps. Since the comparison is with the last kept point,
+// a jump followed by a return to the original track only removes the jumped points.
+// If 3 or more consecutive points are removed but each is within maxStepMeters of
+// the one before, the track is assumed to have really moved, e.g. after a tunnel or
+// a gap in the data, and those points are kept. Assumes lng/lat points. Modifies the path.
+func (p *Path) RemoveOutliers(maxStepMeters float64, haversine ...bool) *Path {
+	if len(p.points) <= 1 {
+		return p
+	}
+
+	yesgeo := yesHaversine(haversine)
+
+	kept := 1
+	runStart, runLength := 0, 0
+	for i := 1; i < len(p.points); i++ {
+		if p.points[kept-1].GeoDistanceFrom(&p.points[i], yesgeo) <= maxStepMeters {
+			p.points[kept] = p.points[i]
+			kept++
+			runLength = 0
+
+			continue
+		}
+
+		// removed points that agree with each other may be the track relocating
+		if runLength > 0 && p.points[i-1].GeoDistanceFrom(&p.points[i], yesgeo) <= maxStepMeters {
+			runLength++
+		} else {
+			runStart, runLength = i, 1
+		}
+
+		if runLength == outlierRelocationPoints {
+			for j := runStart; j <= i; j++ {
+				p.points[kept] = p.points[j]
+				kept++
+			}
+
+			runLength = 0
+		}
+	}
+
+	p.points = p.points[:kept]
+	return p
+}
+
+// IdleSegments returns the inclusive index ranges, [start, end], where at least minPoints
+// consecutive points stay within radius of the first point in the run, such as a vehicle
+// stopped with GPS jitter. Overlapping runs are merged into maximal ranges.
+// Distances are Euclidean so lng/lat data should be projected first for radius to be in meters.
+func (p *Path) IdleSegments(radius float64, minPoints int) [][2]int {
+	if minPoints < 1 {
+		minPoints = 1
+	}
+
+	var segments [][2]int
+	for i := range p.points {
+		end := i
+		for end+1 < len(p.points) && p.points[i].DistanceFrom(&p.points[end+1]) <= radius {
+			end++
+		}
+
+		if end-i+1 < minPoints {
+			continue
+		}
+
+		if l := len(segments); l > 0 && i <= segments[l-1][1] {
+			if end > segments[l-1][1] {
+				segments[l-1][1] = end
+			}
+			continue
+		}
+
+		segments = append(segments, [2]int{i, end})
+	}
+
+	return segments
+}
+
 // Push appends a point to the end of the path.
 func (p *Path) Push(point *Point) *Path {
 	p.points = append(p.points, *point)
@@ -630,6 +1613,44 @@ func (p *Path) Equals(path *Path) bool {
 	return true
 }
 
+// EqualsIgnoringDirection compares two paths allowing for the other path
+// to be reversed. Points are considered equal if both coordinates are within epsilon.
+// Useful for comparing undirected paths, such as road segments, that may
+// have been digitized in either direction.
+func (p *Path) EqualsIgnoringDirection(path *Path, epsilon float64) bool {
+	if p.Length() != path.Length() {
+		return false
+	}
+
+	forward, backward := true, true
+	last := len(path.points) - 1
+	for i := range p.points {
+		if forward && !pointsWithin(&p.points[i], &path.points[i], epsilon) {
+			forward = false
+		}
+
+		if backward && !pointsWithin(&p.points[i], &path.points[last-i], epsilon) {
+			backward = false
+		}
+
+		if !forward && !backward {
+			return false
+		}
+	}
+
+	return true
+}
+
+// EqualsUndirected is the same as EqualsIgnoringDirection. It returns true
+// if the paths are equal, within tolerance, as is or with one of them reversed.
+func (p *Path) EqualsUndirected(path *Path, tolerance float64) bool {
+	return p.EqualsIgnoringDirection(path, tolerance)
+}
+
+func pointsWithin(a, b *Point, epsilon float64) bool {
+	return math.Abs(a[0]-b[0]) <= epsilon && math.Abs(a[1]-b[1]) <= epsilon
+}
+
 // Clone returns a new copy of the path.
 func (p *Path) Clone() *Path {
 	points := make([]Point, len(p.points))
@@ -0,0 +1,38 @@
+package geo
+
+// Path3 is a path with an elevation, or z value, for each point.
+// It is created by draping a 2D path over some elevation data, see Path.Drape.
+type Path3 struct {
+	path       *Path
+	elevations []float64
+}
+
+// Drape creates a 3D path by sampling the elevation at each vertex of the lng/lat path.
+// The sampler can be anything, for example the ValueAt method of a Surface
+// holding elevation data. The original path is copied and not modified.
+func (p *Path) Drape(sample func(lng, lat float64) float64) *Path3 {
+	elevations := make([]float64, len(p.points))
+	for i, point := range p.points {
+		elevations[i] = sample(point.Lng(), point.Lat())
+	}
+
+	return &Path3{
+		path:       p.Clone(),
+		elevations: elevations,
+	}
+}
+
+// Path returns the 2D path with the horizontal components of the points.
+func (p *Path3) Path() *Path {
+	return p.path
+}
+
+// Elevations returns the elevation, or z value, of each point in the path.
+func (p *Path3) Elevations() []float64 {
+	return p.elevations
+}
+
+// Length returns the number of points in the path.
+func (p *Path3) Length() int {
+	return len(p.elevations)
+}
@@ -0,0 +1,41 @@
+package geo
+
+import (
+	"testing"
+)
+
+func TestPathDrape(t *testing.T) {
+	// elevation increases to the north east
+	s := NewSurface(NewBound(0, 10, 0, 10), 3, 3)
+	s.Grid[0] = []float64{0, 5, 10}
+	s.Grid[1] = []float64{5, 10, 15}
+	s.Grid[2] = []float64{10, 15, 20}
+
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(5, 5))
+	p.Push(NewPoint(2.5, 0))
+
+	p3 := p.Drape(func(lng, lat float64) float64 {
+		return s.ValueAt(NewPoint(lng, lat))
+	})
+
+	if p3.Length() != 3 {
+		t.Fatalf("path3, drape expected 3 points, got %d", p3.Length())
+	}
+
+	if !p3.Path().Equals(p) {
+		t.Errorf("path3, drape should keep the 2D points, got %v", p3.Path().Points())
+	}
+
+	if p3.Path() == p {
+		t.Error("path3, drape should copy the path")
+	}
+
+	expected := []float64{0, 10, 2.5}
+	for i, e := range p3.Elevations() {
+		if e != expected[i] {
+			t.Errorf("path3, drape elevation %d expected %f, got %f", i, expected[i], e)
+		}
+	}
+}
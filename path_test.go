@@ -2,8 +2,12 @@ package geo
 
 import (
 	"bytes"
+	"encoding/json"
+	"errors"
 	"math"
 	"math/rand"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -52,6 +56,46 @@ func TestNewPathFromEncoding(t *testing.T) {
 	}
 }
 
+func TestNewPathFromPoints(t *testing.T) {
+	points := []*Point{NewPoint(1, 2), NewPoint(3, 4)}
+
+	p := NewPathFromPoints(points...)
+	if l := p.Length(); l != 2 {
+		t.Fatalf("path, should take all the points, got %d", l)
+	}
+
+	if c := cap(p.Points()); c != 2 {
+		t.Errorf("path, should presize the points, got capacity %d", c)
+	}
+
+	if !p.GetAt(0).Equals(points[0]) || !p.GetAt(1).Equals(points[1]) {
+		t.Errorf("path, points incorrect, got %v", p.Points())
+	}
+
+	points[0].SetX(10)
+	if p.GetAt(0).X() != 1 {
+		t.Error("path, points should be copied")
+	}
+
+	if l := NewPathFromPoints().Length(); l != 0 {
+		t.Errorf("path, should be empty, got %d", l)
+	}
+}
+
+func TestNewPathFromLatLngs(t *testing.T) {
+	data := [][2]float64{{37.7833, -122.4167}, {37.7843, -122.4157}}
+
+	p := NewPathFromLatLngs(data, LatLng)
+	if point := p.GetAt(1); point.Lat() != 37.7843 || point.Lng() != -122.4157 {
+		t.Errorf("path, lat/lng order incorrect, got %v", point)
+	}
+
+	p = NewPathFromLatLngs(data, LngLat)
+	if point := p.GetAt(1); point.Lng() != 37.7843 || point.Lat() != -122.4157 {
+		t.Errorf("path, lng/lat order incorrect, got %v", point)
+	}
+}
+
 func TestNewPathFromXYData(t *testing.T) {
 	data := [][2]float64{
 		[2]float64{1, 2},
@@ -92,6 +136,36 @@ func TestNewPathFromYXData(t *testing.T) {
 	}
 }
 
+func TestPathLineStringCoordOrder(t *testing.T) {
+	data := [][2]float64{
+		[2]float64{-122.4167, 37.7833},
+		[2]float64{-122.4157, 37.7843},
+	}
+
+	p := NewPathFromLineString(data, LngLat)
+	if point := p.GetAt(0); point.Lng() != -122.4167 || point.Lat() != 37.7833 {
+		t.Errorf("path, lng/lat order incorrect, got %v", point)
+	}
+
+	if d := p.ToLineString(LngLat); !reflect.DeepEqual(d, data) {
+		t.Errorf("path, lng/lat round trip incorrect, got %v", d)
+	}
+
+	latlng := p.ToLineString(LatLng)
+	if latlng[1] != [2]float64{37.7843, -122.4157} {
+		t.Errorf("path, lat/lng order incorrect, got %v", latlng)
+	}
+
+	p2 := NewPathFromLineString(latlng, LatLng)
+	if !p2.Equals(p) {
+		t.Errorf("path, lat/lng round trip incorrect, got %v", p2.Points())
+	}
+
+	if d := p2.ToLineString(LatLng); !reflect.DeepEqual(d, latlng) {
+		t.Errorf("path, lat/lng round trip incorrect, got %v", d)
+	}
+}
+
 func TestNewPathFromXYSlice(t *testing.T) {
 	data := [][]float64{
 		[]float64{1, 2, -1},
@@ -164,6 +238,49 @@ func TestPathPoints(t *testing.T) {
 	}
 }
 
+func TestPathRound(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(-122.41670049, 37.8870000001))
+	p.Push(NewPoint(1.0000004, 2))
+
+	data, _ := json.Marshal(p.Round(6))
+	if s := string(data); s != "[[-122.4167,37.887],[1,2]]" {
+		t.Errorf("path, round expected clean json, got %s", s)
+	}
+}
+
+func TestPathTruncate(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(-122.41678, 37.88721))
+	p.Push(NewPoint(1.0004, 1.9996))
+
+	answer := NewPath()
+	answer.Push(NewPoint(-122.417, 37.887))
+	answer.Push(NewPoint(1, 2))
+
+	if p.Truncate(3); !p.Equals(answer) {
+		t.Errorf("path, truncate expected %v, got %v", answer, p)
+	}
+}
+
+func TestPathSnapToGrid(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(0.9, 2.1))
+	p.Push(NewPoint(1.1, 1.9))
+	p.Push(NewPoint(3.6, 0.4))
+
+	p.SnapToGrid(1.0)
+
+	answer := NewPath()
+	answer.Push(NewPoint(1, 2))
+	answer.Push(NewPoint(1, 2))
+	answer.Push(NewPoint(4, 0))
+
+	if !p.Equals(answer) {
+		t.Errorf("path, snap to grid expected %v, got %v", answer.Points(), p.Points())
+	}
+}
+
 func TestPathResample(t *testing.T) {
 	p := NewPath()
 	p.Resample(10) // should not panic
@@ -227,6 +344,249 @@ func TestPathResample(t *testing.T) {
 	}
 }
 
+func TestPathResampleWithHeadings(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(10, 0))
+	p.Push(NewPoint(10, 5))
+
+	p, headings := p.ResampleWithHeadings(4)
+
+	answer := NewPath()
+	answer.Push(NewPoint(0, 0))
+	answer.Push(NewPoint(4, 0))
+	answer.Push(NewPoint(8, 0))
+	answer.Push(NewPoint(10, 2))
+	answer.Push(NewPoint(10, 5))
+
+	if !p.Equals(answer) {
+		t.Errorf("path, resample with headings expected %v, got %v", answer.Points(), p.Points())
+	}
+
+	expected := []float64{0, 0, 0, math.Pi / 2, math.Pi / 2}
+	if len(headings) != len(expected) {
+		t.Fatalf("path, resample with headings expected %d headings, got %d", len(expected), len(headings))
+	}
+
+	for i := range expected {
+		if headings[i] != expected[i] {
+			t.Errorf("path, resample with headings expected heading %f, got %f", expected[i], headings[i])
+		}
+	}
+
+	// exact multiple of interval and repeated points
+	p = NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(0, -4))
+
+	p, headings = p.ResampleWithHeadings(2)
+	if p.Length() != 3 || len(headings) != 3 {
+		t.Errorf("path, resample with headings expected 3 points, got %v", p.Points())
+	}
+
+	if headings[2] != -math.Pi/2 {
+		t.Errorf("path, resample with headings last heading incorrect, got %f", headings[2])
+	}
+
+	// empty path
+	p, headings = NewPath().ResampleWithHeadings(1)
+	if p.Length() != 0 || len(headings) != 0 {
+		t.Error("path, resample with headings of empty path should be empty")
+	}
+}
+
+func TestPathResampleWithHeadingsPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("path, expect resample with headings to panic if interval not positive")
+		}
+	}()
+
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(1, 0))
+	p.ResampleWithHeadings(0)
+}
+
+func TestPathResampleKeepingVertices(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(10, 0))
+	p.Push(NewPoint(10, 1))
+	p.Push(NewPoint(10, 5))
+
+	p.ResampleKeepingVertices(4)
+
+	answer := NewPath()
+	answer.Push(NewPoint(0, 0))
+	answer.Push(NewPoint(10.0/3, 0))
+	answer.Push(NewPoint(20.0/3, 0))
+	answer.Push(NewPoint(10, 0))
+	answer.Push(NewPoint(10, 1))
+	answer.Push(NewPoint(10, 5))
+
+	if p.Length() != answer.Length() {
+		t.Fatalf("path, resample keeping vertices expected %v, got %v", answer.Points(), p.Points())
+	}
+
+	for i := 0; i < p.Length(); i++ {
+		if p.GetAt(i).DistanceFrom(answer.GetAt(i)) > epsilon {
+			t.Errorf("path, resample keeping vertices point %d expected %v, got %v", i, answer.GetAt(i), p.GetAt(i))
+		}
+	}
+
+	// repeated points are kept
+	p = NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(0, -4))
+
+	p.ResampleKeepingVertices(1)
+	if l := p.Length(); l != 6 {
+		t.Errorf("path, resample keeping vertices expected 6 points, got %v", p.Points())
+	}
+
+	// short paths
+	if l := NewPath().ResampleKeepingVertices(1).Length(); l != 0 {
+		t.Errorf("path, resample keeping vertices of empty path should be empty, got %d", l)
+	}
+
+	p = NewPath()
+	p.Push(NewPoint(1, 1))
+	if l := p.ResampleKeepingVertices(1).Length(); l != 1 {
+		t.Errorf("path, resample keeping vertices of single point path should be unchanged, got %d", l)
+	}
+}
+
+func TestPathResampleKeepingVerticesPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("path, expect resample keeping vertices to panic if spacing not positive")
+		}
+	}()
+
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(1, 0))
+	p.ResampleKeepingVertices(-1)
+}
+
+func TestPathGeoDensify(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(10, 0))
+
+	distance := p.GeoDistance(true)
+	p.GeoDensify(distance / 5.5)
+
+	if l := p.Length(); l != 7 {
+		t.Fatalf("path, geoDensify expected 7 points, got %v", p.Points())
+	}
+
+	for i := 0; i < p.Length(); i++ {
+		expected := NewPoint(10*float64(i)/6, 0)
+		if p.GetAt(i).DistanceFrom(expected) > epsilon {
+			t.Errorf("path, geoDensify point %d expected %v, got %v", i, expected, p.GetAt(i))
+		}
+	}
+
+	// should follow the great circle toward the pole
+	p = NewPath()
+	p.Push(NewPoint(-80, 60))
+	p.Push(NewPoint(80, 60))
+	p.GeoDensify(100000)
+
+	maxLat := 0.0
+	for i := 0; i < p.Length()-1; i++ {
+		maxLat = math.Max(maxLat, p.GetAt(i).Lat())
+		if d := p.GetAt(i).GeoDistanceFrom(p.GetAt(i+1), true); d > 100000+1 {
+			t.Errorf("path, geoDensify segment %d too long, got %f", i, d)
+		}
+	}
+
+	if expected := rad2deg(math.Atan(math.Tan(deg2rad(60)) / math.Cos(deg2rad(80)))); math.Abs(maxLat-expected) > 0.01 {
+		t.Errorf("path, geoDensify expected to reach latitude %f, got %f", expected, maxLat)
+	}
+
+	if !p.GetAt(0).Equals(NewPoint(-80, 60)) || !p.GetAt(p.Length()-1).Equals(NewPoint(80, 60)) {
+		t.Errorf("path, geoDensify should keep the end points")
+	}
+
+	// short segments and repeated points are unchanged
+	p = NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(0.0001, 0))
+	if l := p.GeoDensify(1000).Length(); l != 3 {
+		t.Errorf("path, geoDensify expected 3 points, got %d", l)
+	}
+}
+
+func TestPathGeoDensifyPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("path, expect geoDensify to panic if length not positive")
+		}
+	}()
+
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(1, 0))
+	p.GeoDensify(0)
+}
+
+func TestPathSamplePoints(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(1, 0))
+	p.Push(NewPoint(1, 3))
+
+	rng := rand.New(rand.NewSource(42))
+	points := p.SamplePoints(4000, rng)
+	if l := len(points); l != 4000 {
+		t.Fatalf("path, samplePoints expected 4000 points, got %d", l)
+	}
+
+	first := 0
+	for _, point := range points {
+		if d := p.DistanceFrom(point); d > epsilon {
+			t.Fatalf("path, samplePoints point should be on the path, got %v", point)
+		}
+
+		if point.Y() == 0 && point.X() < 1 {
+			first++
+		}
+	}
+
+	// the first segment is a quarter of the length
+	if first < 900 || first > 1100 {
+		t.Errorf("path, samplePoints expected about 1000 on the first segment, got %d", first)
+	}
+
+	// same seed, same points
+	again := p.SamplePoints(4000, rand.New(rand.NewSource(42)))
+	if !reflect.DeepEqual(points, again) {
+		t.Error("path, samplePoints should be deterministic for the same source")
+	}
+
+	if l := len(p.SamplePoints(3, nil)); l != 3 {
+		t.Errorf("path, samplePoints with nil source expected 3 points, got %d", l)
+	}
+
+	// degenerate paths
+	if l := len(NewPath().SamplePoints(3, rng)); l != 0 {
+		t.Errorf("path, samplePoints of empty path expected none, got %d", l)
+	}
+
+	p = NewPath()
+	p.Push(NewPoint(1, 2))
+	points = p.SamplePoints(2, rng)
+	if len(points) != 2 || !points[0].Equals(NewPoint(1, 2)) {
+		t.Errorf("path, samplePoints of single point path incorrect, got %v", points)
+	}
+}
+
 func TestPathEncode(t *testing.T) {
 	for loop := 0; loop < 100; loop++ {
 		p := NewPath()
@@ -249,6 +609,217 @@ func TestPathEncode(t *testing.T) {
 	}
 }
 
+func TestPathEncodingGoogleExample(t *testing.T) {
+	// https://developers.google.com/maps/documentation/utilities/polylinealgorithm
+	encoded := "_p~iF~ps|U_ulLnnqC_mqNvxq`@"
+
+	expected := NewPath()
+	expected.Push(NewPoint(-120.2, 38.5))
+	expected.Push(NewPoint(-120.95, 40.7))
+	expected.Push(NewPoint(-126.453, 43.252))
+
+	p := NewPathFromEncoding(encoded)
+	if !p.EqualsIgnoringDirection(expected, epsilon) || !p.GetAt(0).Equals(expected.GetAt(0)) {
+		t.Errorf("path, decode expected %v, got %v", expected.Points(), p.Points())
+	}
+
+	if p.GetAt(0).Lat() != 38.5 || p.GetAt(0).Lng() != -120.2 {
+		t.Errorf("path, decode axis order incorrect, got %v", p.GetAt(0))
+	}
+
+	if e := expected.Encode(); e != encoded {
+		t.Errorf("path, encode expected %s, got %s", encoded, e)
+	}
+
+	if e := p.Encode(); e != encoded {
+		t.Errorf("path, encode of decoded expected %s, got %s", encoded, e)
+	}
+
+	if d := NewPathFromEncoding(p.Encode()); !d.Equals(p) {
+		t.Errorf("path, decode of encode should be equal, got %v", d.Points())
+	}
+}
+
+func TestPolylineCodec(t *testing.T) {
+	p := NewPath()
+	for i := 0; i < 100; i++ {
+		p.Push(&Point{rand.Float64()*360 - 180, rand.Float64()*180 - 90})
+	}
+
+	if e := DefaultPolylineCodec.Encode(p); e != p.Encode() {
+		t.Errorf("codec, default should match path encode, got %s", e)
+	}
+
+	c := PolylineCodec{Factor: 1.0e6, Round: true}
+	if e := c.Encode(p); e != p.Encode(1.0e6) {
+		t.Errorf("codec, factor should match path encode, got %s", e)
+	}
+
+	var buf bytes.Buffer
+	if _, err := c.EncodeTo(&buf, p); err != nil || buf.String() != c.Encode(p) {
+		t.Errorf("codec, encode to should match encode, got %s, %v", buf.String(), err)
+	}
+
+	if d := c.Decode(c.Encode(p)); !d.Equals(NewPathFromEncoding(p.Encode(1.0e6), 1.0e6)) {
+		t.Errorf("codec, decode should match path decode, got %v", d)
+	}
+
+	// truncating vs rounding
+	p = NewPath()
+	p.Push(NewPoint(1.000009, -1.000009))
+
+	round := PolylineCodec{Round: true}
+	if d := round.Decode(round.Encode(p)); !d.GetAt(0).Equals(NewPoint(1.00001, -1.00001)) {
+		t.Errorf("codec, round expected [1.00001, -1.00001], got %v", d.GetAt(0))
+	}
+
+	truncate := PolylineCodec{}
+	if d := truncate.Decode(truncate.Encode(p)); !d.GetAt(0).Equals(NewPoint(1, -1)) {
+		t.Errorf("codec, truncate expected [1, -1], got %v", d.GetAt(0))
+	}
+}
+
+func TestPathEncodeCompact(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(1, 2))
+	p.Push(NewPoint(1.000001, 2.000001))
+	p.Push(NewPoint(1, 2))
+	p.Push(NewPoint(3, 4))
+	p.Push(NewPoint(3, 4))
+
+	encoded := p.EncodeCompact()
+	if l := len(p.Encode()); len(encoded) >= l {
+		t.Errorf("path, encode compact should be smaller, %d >= %d", len(encoded), l)
+	}
+
+	expected := NewPath()
+	expected.Push(NewPoint(0, 0))
+	expected.Push(NewPoint(1, 2))
+	expected.Push(NewPoint(3, 4))
+
+	if decoded := NewPathFromEncoding(encoded); !decoded.Equals(expected) {
+		t.Errorf("path, encode compact expected %v, got %v", expected.Points(), decoded.Points())
+	}
+
+	// with factor the points are no longer the same
+	if decoded := NewPathFromEncoding(p.EncodeCompact(int(1.0e6)), int(1.0e6)); decoded.Length() != 5 {
+		t.Errorf("path, encode compact with factor expected 5 points, got %v", decoded.Points())
+	}
+
+	if NewPath().EncodeCompact() != "" {
+		t.Error("path, encode compact empty path should be empty string")
+	}
+}
+
+type failingWriter struct {
+	limit  int
+	err    bool
+	failed int // writes after the first error
+}
+
+func (w *failingWriter) Write(b []byte) (int, error) {
+	if w.err {
+		w.failed++
+	}
+
+	if w.limit < len(b) {
+		n := w.limit
+		w.limit = 0
+		w.err = true
+		return n, errors.New("write limit reached")
+	}
+
+	w.limit -= len(b)
+	return len(b), nil
+}
+
+func TestPathCanonicalEncode(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(-120.2, 38.5))
+	p.Push(NewPoint(-120.95, 40.7))
+	p.Push(NewPoint(-126.453, 43.252))
+
+	reversed := NewPath()
+	for i := p.Length() - 1; i >= 0; i-- {
+		reversed.Push(p.GetAt(i))
+	}
+
+	e1, r1 := p.CanonicalEncode()
+	e2, r2 := reversed.CanonicalEncode()
+
+	if e1 != e2 {
+		t.Errorf("path, canonicalEncode should match for reversed paths, got %s and %s", e1, e2)
+	}
+
+	if r1 == r2 {
+		t.Errorf("path, canonicalEncode exactly one path should be reversed, got %v and %v", r1, r2)
+	}
+
+	if r1 {
+		if e := reversed.Encode(); e1 != e {
+			t.Errorf("path, canonicalEncode expected %s, got %s", e, e1)
+		}
+	} else {
+		if e := p.Encode(); e1 != e {
+			t.Errorf("path, canonicalEncode expected %s, got %s", e, e1)
+		}
+	}
+
+	if e, _ := p.CanonicalEncode(1e6); e != p.Encode(1e6) && e != reversed.Encode(1e6) {
+		t.Errorf("path, canonicalEncode should respect factor, got %s", e)
+	}
+
+	// should not modify the path
+	if p.GetAt(0).Lng() != -120.2 {
+		t.Errorf("path, canonicalEncode should not modify the path, got %v", p)
+	}
+}
+
+func TestPathEncodeTo(t *testing.T) {
+	// https://developers.google.com/maps/documentation/utilities/polylinealgorithm
+	p := NewPath()
+	p.Push(NewPoint(-120.2, 38.5))
+	p.Push(NewPoint(-120.95, 40.7))
+	p.Push(NewPoint(-126.453, 43.252))
+
+	var buf bytes.Buffer
+	n, err := p.EncodeTo(&buf)
+	if err != nil {
+		t.Fatalf("path, encode to unexpected error: %v", err)
+	}
+
+	if expected := "_p~iF~ps|U_ulLnnqC_mqNvxq`@"; buf.String() != expected {
+		t.Errorf("path, encode to expected %s, got %s", expected, buf.String())
+	}
+
+	if n != buf.Len() {
+		t.Errorf("path, encode to expected %d bytes written, got %d", buf.Len(), n)
+	}
+
+	buf.Reset()
+	p.EncodeTo(&buf, int(1.0e6))
+	if expected := "_izlhA~rlgdF_{geC~ywl@_kwzCn`{nI"; buf.String() != expected {
+		t.Errorf("path, encode to with factor expected %s, got %s", expected, buf.String())
+	}
+
+	// writer errors are returned
+	w := &failingWriter{limit: 10}
+	n, err = p.EncodeTo(w)
+	if err == nil {
+		t.Error("path, encode to expected writer error")
+	}
+
+	if n != 10 {
+		t.Errorf("path, encode to expected 10 bytes written, got %d", n)
+	}
+
+	if w.failed != 0 {
+		t.Errorf("path, encode to should stop at the first error, got %d more writes", w.failed)
+	}
+}
+
 func TestPathDistance(t *testing.T) {
 	p := NewPath()
 	p.Push(NewPoint(0, 0))
@@ -260,6 +831,141 @@ func TestPathDistance(t *testing.T) {
 	}
 }
 
+func TestPathVincentyTotalDistance(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(144.42486788888889, -37.95103341666667))
+	p.Push(NewPoint(143.92649552777777, -37.65282113888889))
+	p.Push(NewPoint(144.42486788888889, -37.95103341666667))
+
+	d, err := p.VincentyTotalDistance()
+	if err != nil || math.Abs(d-2*54972.271) > 0.002 {
+		t.Errorf("path, vincentyTotalDistance expected %f, got %f, %v", 2*54972.271, d, err)
+	}
+
+	if d, err := NewPath().VincentyTotalDistance(); d != 0 || err != nil {
+		t.Errorf("path, vincentyTotalDistance of empty path expected 0, got %f, %v", d, err)
+	}
+
+	// partial sum on failure
+	p = NewPath()
+	p.Push(NewPoint(-1, 0))
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(179.7, 0.5))
+
+	d, err = p.VincentyTotalDistance()
+	if expected, _ := p.GetAt(0).VincentyDistanceFrom(p.GetAt(1)); err != ErrVincentyNoConvergence || d != expected {
+		t.Errorf("path, vincentyTotalDistance expected partial %f, got %f, %v", expected, d, err)
+	}
+}
+
+func TestPathGeoArea(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(1, 0))
+	p.Push(NewPoint(1, 1))
+	p.Push(NewPoint(0, 1))
+
+	expected := EarthRadius * EarthRadius * deg2rad(1) * math.Sin(deg2rad(1))
+	if a := p.GeoArea(); math.Abs(a-expected) > 1 {
+		t.Errorf("path, geoArea expected %f, got %f", expected, a)
+	}
+
+	// unit sphere
+	if a := p.GeoArea(1); math.Abs(a-expected/EarthRadius/EarthRadius) > epsilon {
+		t.Errorf("path, geoArea with radius expected %f, got %f", expected/EarthRadius/EarthRadius, a)
+	}
+
+	// explicitly closed
+	p.Push(NewPoint(0, 0))
+	if a := p.GeoArea(); math.Abs(a-expected) > 1 {
+		t.Errorf("path, geoArea of closed path expected %f, got %f", expected, a)
+	}
+
+	// clockwise
+	p = NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(0, 1))
+	p.Push(NewPoint(1, 1))
+	p.Push(NewPoint(1, 0))
+	if a := p.GeoArea(); math.Abs(a-expected) > 1 {
+		t.Errorf("path, geoArea of clockwise path expected %f, got %f", expected, a)
+	}
+
+	// small field, should be close to the planar area
+	p = NewPath()
+	p.Push(NewPoint(-122.4167, 37.7833))
+	p.Push(NewPoint(-122.4167, 37.7843))
+	p.Push(NewPoint(-122.4157, 37.7843))
+
+	width := NewPoint(-122.4167, 37.7843).GeoDistanceFrom(NewPoint(-122.4157, 37.7843))
+	height := NewPoint(-122.4167, 37.7833).GeoDistanceFrom(NewPoint(-122.4167, 37.7843))
+	if a := p.GeoArea(); math.Abs(a-width*height/2) > 0.01*a {
+		t.Errorf("path, geoArea expected %f, got %f", width*height/2, a)
+	}
+
+	p = NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(1, 1))
+	if a := p.GeoArea(); a != 0 {
+		t.Errorf("path, geoArea of short path should be 0, got %f", a)
+	}
+}
+
+func TestPathSinuosity(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(1, 0))
+	p.Push(NewPoint(2, 0))
+
+	if s := p.Sinuosity(); s != 1 {
+		t.Errorf("path, sinuosity of straight path expected 1, got %f", s)
+	}
+
+	p.SetAt(1, NewPoint(1, 1))
+	if s := p.Sinuosity(); math.Abs(s-math.Sqrt2) > epsilon {
+		t.Errorf("path, sinuosity expected %f, got %f", math.Sqrt2, s)
+	}
+
+	// loop
+	p.Push(NewPoint(0, 0))
+	if s := p.Sinuosity(); !math.IsInf(s, 1) {
+		t.Errorf("path, sinuosity of loop expected +Inf, got %f", s)
+	}
+
+	// no length
+	p = NewPath()
+	p.Push(NewPoint(1, 1))
+	p.Push(NewPoint(1, 1))
+	if s := p.Sinuosity(); s != 1 {
+		t.Errorf("path, sinuosity of zero length path expected 1, got %f", s)
+	}
+
+	if s := NewPath().Sinuosity(); s != 1 {
+		t.Errorf("path, sinuosity of empty path expected 1, got %f", s)
+	}
+}
+
+func TestPathGeoSinuosity(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(-122.4167, 37.7833))
+	p.Push(NewPoint(-122.4157, 37.7833))
+	p.Push(NewPoint(-122.4157, 37.7843))
+
+	expected := p.GeoDistance(true) / p.GetAt(0).GeoDistanceFrom(p.GetAt(2), true)
+	if s := p.GeoSinuosity(true); math.Abs(s-expected) > epsilon {
+		t.Errorf("path, geoSinuosity expected %f, got %f", expected, s)
+	}
+
+	if s := p.GeoSinuosity(); s <= 1 || s > math.Sqrt2+0.01 {
+		t.Errorf("path, geoSinuosity incorrect, got %f", s)
+	}
+
+	p.Push(NewPoint(-122.4167, 37.7833))
+	if s := p.GeoSinuosity(); !math.IsInf(s, 1) {
+		t.Errorf("path, geoSinuosity of loop expected +Inf, got %f", s)
+	}
+}
+
 func TestPathDistanceFrom(t *testing.T) {
 	var answer float64
 
@@ -299,24 +1005,238 @@ func TestPathSquaredDistanceFrom(t *testing.T) {
 	p.Push(NewPoint(4, 3))
 	p.Push(NewPoint(4, 0))
 
-	answer = 0.25
-	if d := p.SquaredDistanceFrom(NewPoint(4.5, 1.5)); math.Abs(d-answer) > epsilon {
-		t.Errorf("path, squaredDistanceFrom expected %f, got: %f", answer, d)
+	answer = 0.25
+	if d := p.SquaredDistanceFrom(NewPoint(4.5, 1.5)); math.Abs(d-answer) > epsilon {
+		t.Errorf("path, squaredDistanceFrom expected %f, got: %f", answer, d)
+	}
+
+	answer = 0.16
+	if d := p.SquaredDistanceFrom(NewPoint(0.4, 1.5)); math.Abs(d-answer) > epsilon {
+		t.Errorf("path, squaredDistanceFrom expected %f, got: %f", answer, d)
+	}
+
+	answer = 0.09
+	if d := p.SquaredDistanceFrom(NewPoint(-0.3, 1.5)); math.Abs(d-answer) > epsilon {
+		t.Errorf("path, squaredDistanceFrom expected %f, got: %f", answer, d)
+	}
+
+	answer = 0.04
+	if d := p.SquaredDistanceFrom(NewPoint(0.3, 2.8)); math.Abs(d-answer) > epsilon {
+		t.Errorf("path, squaredDistanceFrom expected %f, got: %f", answer, d)
+	}
+}
+
+func TestPathDistanceFromPath(t *testing.T) {
+	p1 := NewPath()
+	p1.Push(NewPoint(0, 0))
+	p1.Push(NewPoint(10, 0))
+	p1.Push(NewPoint(10, -10))
+
+	p2 := NewPath()
+	p2.Push(NewPoint(0, 5))
+	p2.Push(NewPoint(10, 3))
+	p2.Push(NewPoint(20, 10))
+
+	if d := p1.DistanceFromPath(p2); d != 3 {
+		t.Errorf("path, distance from path expected 3, got %f", d)
+	}
+
+	if d := p2.DistanceFromPath(p1); d != 3 {
+		t.Errorf("path, distance from path expected 3, got %f", d)
+	}
+
+	// intersecting
+	p3 := NewPath()
+	p3.Push(NewPoint(5, -5))
+	p3.Push(NewPoint(5, 5))
+
+	if d := p1.DistanceFromPath(p3); d != 0 {
+		t.Errorf("path, distance from intersecting path expected 0, got %f", d)
+	}
+
+	// parallel
+	p4 := NewPath()
+	p4.Push(NewPoint(2, -4))
+	p4.Push(NewPoint(6, -4))
+
+	if d := p1.DistanceFromPath(p4); d != 4 {
+		t.Errorf("path, distance from parallel path expected 4, got %f", d)
+	}
+
+	if d := p1.DistanceFromPath(NewPath()); !math.IsInf(d, 1) {
+		t.Errorf("path, distance from empty path expected inf, got %f", d)
+	}
+}
+
+func TestAlignPaths(t *testing.T) {
+	a := NewPath()
+	a.Push(NewPoint(0, 0))
+	a.Push(NewPoint(1, 0))
+	a.Push(NewPoint(2, 0))
+	a.Push(NewPoint(3, 0))
+
+	b := NewPath()
+	b.Push(NewPoint(0, 1))
+	b.Push(NewPoint(3, 1))
+
+	expected := [][2]int{{0, 0}, {1, 0}, {2, 1}, {3, 1}}
+	if pairs := AlignPaths(a, b); !reflect.DeepEqual(pairs, expected) {
+		t.Errorf("path, align paths expected %v, got %v", expected, pairs)
+	}
+
+	expected = [][2]int{{0, 0}, {0, 1}, {1, 2}, {1, 3}}
+	if pairs := AlignPaths(b, a); !reflect.DeepEqual(pairs, expected) {
+		t.Errorf("path, align paths expected %v, got %v", expected, pairs)
+	}
+
+	// same path aligns to itself
+	expected = [][2]int{{0, 0}, {1, 1}, {2, 2}, {3, 3}}
+	if pairs := AlignPaths(a, a); !reflect.DeepEqual(pairs, expected) {
+		t.Errorf("path, align paths expected %v, got %v", expected, pairs)
+	}
+
+	if pairs := AlignPaths(a, NewPath()); len(pairs) != 0 {
+		t.Errorf("path, align paths with empty path should be empty, got %v", pairs)
+	}
+}
+
+func TestMergeOverlapping(t *testing.T) {
+	p1 := NewPath()
+	p1.Push(NewPoint(0, 0))
+	p1.Push(NewPoint(10, 0))
+
+	// overlaps p1 then diverges
+	p2 := NewPath()
+	p2.Push(NewPoint(1, 0.1))
+	p2.Push(NewPoint(5, -0.1))
+	p2.Push(NewPoint(9, 0.1))
+	p2.Push(NewPoint(9, 5))
+	p2.Push(NewPoint(9, 10))
+
+	// completely covered
+	p3 := NewPath()
+	p3.Push(NewPoint(2, 0.2))
+	p3.Push(NewPoint(8, -0.2))
+	p3.Push(NewPoint(9, 0.3))
+	p3.Push(NewPoint(9.2, 4))
+
+	// endpoints are covered but the segment crosses empty space
+	p4 := NewPath()
+	p4.Push(NewPoint(2, 0))
+	p4.Push(NewPoint(9, 6))
+
+	merged := MergeOverlapping([]*Path{p1, p2, p3, p4}, 0.5)
+	if len(merged) != 3 {
+		t.Fatalf("merge overlapping expected 3 paths, got %d", len(merged))
+	}
+
+	if !merged[0].Equals(p1) {
+		t.Errorf("merge overlapping expected first path to be kept, got %v", merged[0].Points())
+	}
+
+	expected := NewPath()
+	expected.Push(NewPoint(9, 0.1))
+	expected.Push(NewPoint(9, 5))
+	expected.Push(NewPoint(9, 10))
+
+	if !merged[1].Equals(expected) {
+		t.Errorf("merge overlapping expected %v, got %v", expected.Points(), merged[1].Points())
+	}
+
+	if !merged[2].Equals(p4) {
+		t.Errorf("merge overlapping expected %v, got %v", p4.Points(), merged[2].Points())
+	}
+
+	if merged[0] == p1 {
+		t.Error("merge overlapping should return new paths")
+	}
+
+	if merged := MergeOverlapping(nil, 1); len(merged) != 0 {
+		t.Errorf("merge overlapping of nothing should be empty, got %v", merged)
+	}
+}
+
+func TestPathSideOf(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(10, 0))
+	p.Push(NewPoint(10, 10))
+
+	if s := p.SideOf(NewPoint(5, 1)); s != -1 {
+		t.Errorf("path, side of expected left -1, got %d", s)
+	}
+
+	if s := p.SideOf(NewPoint(5, -1)); s != 1 {
+		t.Errorf("path, side of expected right 1, got %d", s)
+	}
+
+	if s := p.SideOf(NewPoint(5, 0)); s != 0 {
+		t.Errorf("path, side of expected on 0, got %d", s)
+	}
+
+	// nearest segment is the second, heading north
+	if s := p.SideOf(NewPoint(11, 8)); s != 1 {
+		t.Errorf("path, side of expected right 1, got %d", s)
+	}
+
+	if s := p.SideOf(NewPoint(9, 8)); s != -1 {
+		t.Errorf("path, side of expected left -1, got %d", s)
+	}
+
+	// reversing the path flips the side
+	r := NewPath()
+	r.Push(NewPoint(10, 0))
+	r.Push(NewPoint(0, 0))
+	if s := r.SideOf(NewPoint(5, 1)); s != 1 {
+		t.Errorf("path, side of reversed expected right 1, got %d", s)
+	}
+
+	if s := NewPath().SideOf(NewPoint(5, 1)); s != 0 {
+		t.Errorf("path, side of empty path expected 0, got %d", s)
+	}
+}
+
+func TestPathClosestPointWithin(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(10, 0))
+	p.Push(NewPoint(10, 10))
+
+	point, ok := p.ClosestPointWithin(NewPoint(5, 1), 2)
+	if !ok || !point.Equals(NewPoint(5, 0)) {
+		t.Errorf("path, closest point within expected [5, 0], got %v %v", point, ok)
+	}
+
+	point, ok = p.ClosestPointWithin(NewPoint(12, 5), 2)
+	if !ok || !point.Equals(NewPoint(10, 5)) {
+		t.Errorf("path, closest point within expected [10, 5], got %v %v", point, ok)
+	}
+
+	point, ok = p.ClosestPointWithin(NewPoint(-1, -1), 2)
+	if !ok || !point.Equals(NewPoint(0, 0)) {
+		t.Errorf("path, closest point within expected [0, 0], got %v %v", point, ok)
 	}
 
-	answer = 0.16
-	if d := p.SquaredDistanceFrom(NewPoint(0.4, 1.5)); math.Abs(d-answer) > epsilon {
-		t.Errorf("path, squaredDistanceFrom expected %f, got: %f", answer, d)
+	// on the boundary
+	point, ok = p.ClosestPointWithin(NewPoint(5, 2), 2)
+	if !ok || !point.Equals(NewPoint(5, 0)) {
+		t.Errorf("path, closest point within expected [5, 0], got %v %v", point, ok)
 	}
 
-	answer = 0.09
-	if d := p.SquaredDistanceFrom(NewPoint(-0.3, 1.5)); math.Abs(d-answer) > epsilon {
-		t.Errorf("path, squaredDistanceFrom expected %f, got: %f", answer, d)
+	point, ok = p.ClosestPointWithin(NewPoint(5, 5), 2)
+	if ok || point != nil {
+		t.Errorf("path, closest point within expected nothing, got %v %v", point, ok)
 	}
 
-	answer = 0.04
-	if d := p.SquaredDistanceFrom(NewPoint(0.3, 2.8)); math.Abs(d-answer) > epsilon {
-		t.Errorf("path, squaredDistanceFrom expected %f, got: %f", answer, d)
+	// single point path
+	p = NewPath()
+	p.Push(NewPoint(1, 1))
+	if point, ok := p.ClosestPointWithin(NewPoint(1, 2), 2); !ok || !point.Equals(NewPoint(1, 1)) {
+		t.Errorf("path, closest point within expected [1, 1], got %v %v", point, ok)
+	}
+
+	if _, ok := NewPath().ClosestPointWithin(NewPoint(1, 2), 2); ok {
+		t.Error("path, closest point within of empty path should not be found")
 	}
 }
 
@@ -353,6 +1273,41 @@ func TestPathDirectionAtPanic(t *testing.T) {
 	NewPath().DirectionAt(0)
 }
 
+func TestPathManeuvers(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(0, 0.01))
+	p.Push(NewPoint(0, 0.02))
+	p.Push(NewPoint(0, 0.02)) // duplicate
+	p.Push(NewPoint(0.01, 0.02))
+	p.Push(NewPoint(0.02, 0.0201))
+	p.Push(NewPoint(0.02, 0.03))
+
+	m := p.Maneuvers(30)
+	if len(m) != 2 {
+		t.Fatalf("path, maneuvers expected 2, got %v", m)
+	}
+
+	// right turn heading north to east
+	if m[0].Index != 2 || !m[0].Location.Equals(NewPoint(0, 0.02)) || m[0].Side != 1 || math.Abs(m[0].Angle-90) > 0.01 {
+		t.Errorf("path, maneuvers incorrect right turn, got %+v", m[0])
+	}
+
+	// left turn heading east to north
+	if m[1].Index != 5 || m[1].Side != -1 || math.Abs(m[1].Angle+90) > 1 {
+		t.Errorf("path, maneuvers incorrect left turn, got %+v", m[1])
+	}
+
+	// small bends are included with a lower threshold
+	if m := p.Maneuvers(0.1); len(m) != 3 {
+		t.Errorf("path, maneuvers expected 3, got %v", m)
+	}
+
+	if m := NewPath().Maneuvers(10); len(m) != 0 {
+		t.Errorf("path, maneuvers of empty path expected none, got %v", m)
+	}
+}
+
 func TestPathMeasure(t *testing.T) {
 	p := NewPath()
 	p.Push(NewPoint(0, 0))
@@ -414,6 +1369,60 @@ func TestPathProject(t *testing.T) {
 	}
 }
 
+func TestPathLocatePoint(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(2, 0))
+	p.Push(NewPoint(2, 4))
+
+	type testCase struct {
+		point     *Point
+		fraction  float64
+		index     int
+		projected *Point
+	}
+
+	tests := []testCase{
+		{NewPoint(1, 1), 0.5, 0, NewPoint(1, 0)},
+		{NewPoint(3, 1), 0.25, 1, NewPoint(2, 1)},
+		{NewPoint(-1, -1), 0, 0, NewPoint(0, 0)},
+		{NewPoint(2, 5), 1, 1, NewPoint(2, 4)},
+		{NewPoint(2, 0), 1, 0, NewPoint(2, 0)},
+	}
+
+	for i, test := range tests {
+		fraction, index, projected := p.LocatePoint(test.point)
+		if math.Abs(fraction-test.fraction) > epsilon {
+			t.Errorf("path, locatePoint %d fraction expected %f, got %f", i, test.fraction, fraction)
+		}
+
+		if index != test.index {
+			t.Errorf("path, locatePoint %d index expected %d, got %d", i, test.index, index)
+		}
+
+		if !projected.Equals(test.projected) {
+			t.Errorf("path, locatePoint %d projected expected %v, got %v", i, test.projected, projected)
+		}
+	}
+
+	// degenerate segments
+	p = NewPath()
+	p.Push(NewPoint(1, 1))
+	p.Push(NewPoint(1, 1))
+
+	if f, i, pr := p.LocatePoint(NewPoint(2, 2)); f != 0 || i != 0 || !pr.Equals(NewPoint(1, 1)) {
+		t.Errorf("path, locatePoint degenerate segment, got %f %d %v", f, i, pr)
+	}
+
+	// short paths
+	p = NewPath()
+	p.Push(NewPoint(1, 1))
+
+	if _, i, pr := p.LocatePoint(NewPoint(2, 2)); i != -1 || pr != nil {
+		t.Errorf("path, locatePoint single point path, got %d %v", i, pr)
+	}
+}
+
 func TestPathIntersection(t *testing.T) {
 	path := NewPath()
 
@@ -494,6 +1503,40 @@ func TestPathIntersectionLine(t *testing.T) {
 	}
 }
 
+func TestPathGeoIntersections(t *testing.T) {
+	p1 := NewPath()
+	p1.Push(NewPoint(-80, 60))
+	p1.Push(NewPoint(80, 60))
+	p1.Push(NewPoint(80, 0))
+
+	p2 := NewPath()
+	p2.Push(NewPoint(0, 80))
+	p2.Push(NewPoint(0, 89))
+
+	if points, _ := p1.IntersectionPath(p2); len(points) != 0 {
+		t.Errorf("path, planar intersection should be empty, got %v", points)
+	}
+
+	points := p1.GeoIntersections(p2)
+	if len(points) != 1 {
+		t.Fatalf("path, geoIntersections expected 1 point, got %v", points)
+	}
+
+	if math.Abs(points[0].Lng()) > epsilon || points[0].Lat() < 84 {
+		t.Errorf("path, geoIntersections incorrect point, got %v", points[0])
+	}
+
+	p2.Push(NewPoint(90, 30))
+	p2.Push(NewPoint(70, 30))
+	if points := p1.GeoIntersections(p2); len(points) != 2 {
+		t.Errorf("path, geoIntersections expected 2 points, got %v", points)
+	}
+
+	if points := p1.GeoIntersections(NewPath()); len(points) != 0 {
+		t.Errorf("path, geoIntersections with empty path should be empty, got %v", points)
+	}
+}
+
 func TestPathIntersects(t *testing.T) {
 	path := NewPath()
 
@@ -569,6 +1612,58 @@ func TestPathIntersectsLine(t *testing.T) {
 	}
 }
 
+func TestPathContains(t *testing.T) {
+	// a U shape
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(3, 0))
+	p.Push(NewPoint(3, 3))
+	p.Push(NewPoint(2, 3))
+	p.Push(NewPoint(2, 1))
+	p.Push(NewPoint(1, 1))
+	p.Push(NewPoint(1, 3))
+	p.Push(NewPoint(0, 3))
+
+	type testCase struct {
+		point  *Point
+		result bool
+	}
+
+	tests := []testCase{
+		{NewPoint(0.5, 0.5), true},
+		{NewPoint(0.5, 2.5), true},
+		{NewPoint(2.5, 2.5), true},
+		{NewPoint(1.5, 2), false},
+		{NewPoint(4, 1), false},
+		{NewPoint(-1, 1), false},
+	}
+
+	closed := p.Clone().Push(NewPoint(0, 0))
+	for i, test := range tests {
+		if r := p.Contains(test.point); r != test.result {
+			t.Errorf("path, contains %d expected %v, got %v", i, test.result, r)
+		}
+
+		if r := closed.Contains(test.point); r != test.result {
+			t.Errorf("path, contains %d closed expected %v, got %v", i, test.result, r)
+		}
+	}
+
+	// shared edge is in exactly one
+	left := testSquare(0, 0, 2)
+	right := testSquare(2, 0, 2)
+	if point := NewPoint(2, 1); left.Contains(point) == right.Contains(point) {
+		t.Errorf("path, contains point on shared edge should be in exactly one")
+	}
+
+	line := NewPath()
+	line.Push(NewPoint(0, 0))
+	line.Push(NewPoint(1, 1))
+	if line.Contains(NewPoint(0.5, 0.5)) {
+		t.Error("path, contains with less than 3 points should be false")
+	}
+}
+
 func TestPathBound(t *testing.T) {
 	p := NewPath()
 	p.Push(NewPoint(0.5, .2))
@@ -585,6 +1680,48 @@ func TestPathBound(t *testing.T) {
 	if !p.Bound().Empty() {
 		t.Error("path, bound, expect empty path to have empty bounds")
 	}
+
+	if p.Bound().Contains(NewPoint(0, 0)) {
+		t.Error("path, bound, empty path bound should not contain the origin")
+	}
+
+	if b := p.Bound().Union(answer); !b.Equals(answer) {
+		t.Errorf("path, bound, empty bound union expected %v, got %v", answer, b)
+	}
+
+	if b := answer.Clone().Union(p.Bound()); !b.Equals(answer) {
+		t.Errorf("path, bound, union with empty bound expected %v, got %v", answer, b)
+	}
+
+	// a path at the origin is not empty
+	p.Push(NewPoint(0, 0))
+	if b := p.Bound(); !b.Contains(NewPoint(0, 0)) {
+		t.Errorf("path, bound, expected bound to contain the origin, got %v", b)
+	}
+}
+
+func TestPathGeoBound(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(-122.559, 37.887))
+	p.Push(NewPoint(-122.521, 37.911))
+
+	b, width, height := p.GeoBound()
+	if !b.Equals(p.Bound()) {
+		t.Errorf("path, geo bound expected %v, got %v", p.Bound(), b)
+	}
+
+	if width != b.GeoWidth() || width == 0 {
+		t.Errorf("path, geo bound width expected %f, got %f", b.GeoWidth(), width)
+	}
+
+	if height != b.GeoHeight() || height == 0 {
+		t.Errorf("path, geo bound height expected %f, got %f", b.GeoHeight(), height)
+	}
+
+	b, width, height = NewPath().GeoBound()
+	if !b.Empty() || width != 0 || height != 0 {
+		t.Errorf("path, geo bound of empty path should be empty, got %v %f %f", b, width, height)
+	}
 }
 
 func TestPathSetAt(t *testing.T) {
@@ -623,6 +1760,192 @@ func TestPathSetAtPanicIndexUnder(t *testing.T) {
 	p.SetAt(-1, NewPoint(3, 4))
 }
 
+func TestPathThinBySpacing(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(0.5, 0))
+	p.Push(NewPoint(1, 0))
+	p.Push(NewPoint(1.2, 0))
+	p.Push(NewPoint(2.5, 0))
+	p.Push(NewPoint(3, 0))
+
+	answer := NewPath()
+	answer.Push(NewPoint(0, 0))
+	answer.Push(NewPoint(1, 0))
+	answer.Push(NewPoint(3, 0))
+
+	if r := p.Clone().ThinBySpacing(1); !r.Equals(answer) {
+		t.Errorf("path, thinBySpacing expected %v, got %v", answer, r)
+	}
+
+	if r := p.Clone().ThinBySpacing(0); !r.Equals(p) {
+		t.Errorf("path, thinBySpacing of 0 should not change the path, got %v", r)
+	}
+
+	// first and last are always kept
+	answer = NewPath()
+	answer.Push(NewPoint(0, 0))
+	answer.Push(NewPoint(3, 0))
+
+	if r := p.Clone().ThinBySpacing(10); !r.Equals(answer) {
+		t.Errorf("path, thinBySpacing expected %v, got %v", answer, r)
+	}
+}
+
+func TestPathGeoThinBySpacing(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(-122.4167, 37.7833))
+	p.Push(NewPoint(-122.41669, 37.7833)) // about 1 meter
+	p.Push(NewPoint(-122.4157, 37.7833))
+	p.Push(NewPoint(-122.4147, 37.7833))
+
+	if r := p.Clone().GeoThinBySpacing(10); r.Length() != 3 || !r.GetAt(1).Equals(p.GetAt(2)) {
+		t.Errorf("path, geoThinBySpacing expected 3 points, got %v", r)
+	}
+
+	if r := p.Clone().GeoThinBySpacing(10, true); r.Length() != 3 {
+		t.Errorf("path, geoThinBySpacing with haversine expected 3 points, got %v", r)
+	}
+}
+
+func TestPathRemoveCollinear(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(1, 0))
+	p.Push(NewPoint(2, 0))
+	p.Push(NewPoint(3, 0.05))
+	p.Push(NewPoint(4, 0))
+	p.Push(NewPoint(4, 1))
+	p.Push(NewPoint(4, 2))
+	p.Push(NewPoint(4, 0.5)) // reversal
+
+	answer := NewPath()
+	answer.Push(NewPoint(0, 0))
+	answer.Push(NewPoint(2, 0))
+	answer.Push(NewPoint(3, 0.05))
+	answer.Push(NewPoint(4, 0))
+	answer.Push(NewPoint(4, 2))
+	answer.Push(NewPoint(4, 0.5))
+
+	if r := p.Clone().RemoveCollinear(0); !r.Equals(answer) {
+		t.Errorf("path, removeCollinear expected %v, got %v", answer, r)
+	}
+
+	answer = NewPath()
+	answer.Push(NewPoint(0, 0))
+	answer.Push(NewPoint(4, 0))
+	answer.Push(NewPoint(4, 2))
+	answer.Push(NewPoint(4, 0.5))
+
+	if r := p.Clone().RemoveCollinear(0.1); !r.Equals(answer) {
+		t.Errorf("path, removeCollinear expected %v, got %v", answer, r)
+	}
+
+	// short paths
+	p = NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(0, 0))
+	if r := p.RemoveCollinear(1); r.Length() != 2 {
+		t.Errorf("path, removeCollinear should not change short paths, got %v", r)
+	}
+}
+
+func TestPathRemoveOutliers(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(-122.4167, 37.7833))
+	p.Push(NewPoint(-122.4166, 37.7833))
+	p.Push(NewPoint(-122.4000, 37.7833)) // jump
+	p.Push(NewPoint(-122.4165, 37.7833))
+	p.Push(NewPoint(-122.4164, 37.7834))
+
+	expected := NewPath()
+	expected.Push(NewPoint(-122.4167, 37.7833))
+	expected.Push(NewPoint(-122.4166, 37.7833))
+	expected.Push(NewPoint(-122.4165, 37.7833))
+	expected.Push(NewPoint(-122.4164, 37.7834))
+
+	if p.RemoveOutliers(50); !p.Equals(expected) {
+		t.Errorf("path, removeOutliers expected %v, got %v", expected.Points(), p.Points())
+	}
+
+	if p.RemoveOutliers(50, true); !p.Equals(expected) {
+		t.Errorf("path, removeOutliers haversine expected %v, got %v", expected.Points(), p.Points())
+	}
+
+	// points too far from each other are all noise, keeps the first point
+	p.RemoveOutliers(1)
+	if l := p.Length(); l != 1 {
+		t.Errorf("path, removeOutliers expected 1 point, got %v", p.Points())
+	}
+
+	// track continues about 1.5km away after a gap
+	p = NewPath()
+	p.Push(NewPoint(-122.4167, 37.7833))
+	p.Push(NewPoint(-122.4166, 37.7833))
+	p.Push(NewPoint(-122.4000, 37.7833))
+	p.Push(NewPoint(-122.3999, 37.7833))
+	p.Push(NewPoint(-122.3998, 37.7833))
+	p.Push(NewPoint(-122.3997, 37.7834))
+
+	expected = p.Clone()
+	if p.RemoveOutliers(50); !p.Equals(expected) {
+		t.Errorf("path, removeOutliers after relocation expected %v, got %v", expected.Points(), p.Points())
+	}
+
+	// too short of a run is still removed
+	p = NewPath()
+	p.Push(NewPoint(-122.4167, 37.7833))
+	p.Push(NewPoint(-122.4000, 37.7833))
+	p.Push(NewPoint(-122.3999, 37.7833))
+	p.Push(NewPoint(-122.4166, 37.7833))
+
+	expected = NewPath()
+	expected.Push(NewPoint(-122.4167, 37.7833))
+	expected.Push(NewPoint(-122.4166, 37.7833))
+
+	if p.RemoveOutliers(50); !p.Equals(expected) {
+		t.Errorf("path, removeOutliers short run expected %v, got %v", expected.Points(), p.Points())
+	}
+
+	if l := NewPath().RemoveOutliers(1).Length(); l != 0 {
+		t.Errorf("path, removeOutliers of empty path should be empty, got %d", l)
+	}
+}
+
+func TestPathIndexError(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(1, 2))
+
+	tests := map[string]func(){
+		"set":          func() { p.SetAt(1, NewPoint(3, 4)) },
+		"insert":       func() { p.InsertAt(2, NewPoint(3, 4)) },
+		"remove":       func() { p.RemoveAt(-1) },
+		"direction at": func() { p.DirectionAt(5) },
+	}
+
+	for op, f := range tests {
+		func() {
+			defer func() {
+				err, ok := recover().(*IndexError)
+				if !ok {
+					t.Fatalf("path, %s expected to panic with an index error", op)
+				}
+
+				if err.Op != op || err.Length != 1 {
+					t.Errorf("path, %s incorrect index error, got %+v", op, err)
+				}
+
+				expected := "geo: " + op + " index out of range"
+				if !strings.HasPrefix(err.Error(), expected) {
+					t.Errorf("path, %s incorrect error message, got %s", op, err.Error())
+				}
+			}()
+
+			f()
+		}()
+	}
+}
+
 func TestPathGetAt(t *testing.T) {
 	path := NewPath()
 	point := NewPoint(1, 2)
@@ -646,6 +1969,63 @@ func TestPathGetAt(t *testing.T) {
 	}
 }
 
+func TestPathEachSegment(t *testing.T) {
+	path := NewPath()
+	path.Push(NewPoint(1, 2))
+	path.Push(NewPoint(3, 4))
+	path.Push(NewPoint(5, 6))
+
+	var indexes []int
+	path.EachSegment(func(i int, l *Line) {
+		indexes = append(indexes, i)
+		if !l.Equals(path.SegmentAt(i)) {
+			t.Errorf("path, eachSegment %d expected %v, got %v", i, path.SegmentAt(i), l)
+		}
+
+		// should not modify the path
+		l.A().SetX(100)
+	})
+
+	if !reflect.DeepEqual(indexes, []int{0, 1}) {
+		t.Errorf("path, eachSegment expected indexes [0 1], got %v", indexes)
+	}
+
+	if path.GetAt(0)[0] != 1 {
+		t.Errorf("path, eachSegment should not modify the path, got %v", path.GetAt(0))
+	}
+
+	// no segments
+	for _, p := range []*Path{NewPath(), NewPath().Push(NewPoint(1, 1))} {
+		p.EachSegment(func(i int, l *Line) {
+			t.Errorf("path, eachSegment should not be called for %v", p)
+		})
+	}
+}
+
+func TestPathSegmentAt(t *testing.T) {
+	path := NewPath()
+	path.Push(NewPoint(1, 2))
+	path.Push(NewPoint(3, 4))
+	path.Push(NewPoint(5, 6))
+
+	answer := NewLine(NewPoint(3, 4), NewPoint(5, 6))
+	if l := path.SegmentAt(1); !l.Equals(answer) {
+		t.Errorf("path, segmentAt expected %v, got %v", answer, l)
+	}
+
+	for _, i := range []int{-1, 2, 10} {
+		if l := path.SegmentAt(i); l != nil {
+			t.Errorf("path, segmentAt %d expected nil, got %v", i, l)
+		}
+	}
+
+	// should be a copy
+	path.SegmentAt(0).A().SetX(100)
+	if path.GetAt(0)[0] != 1 {
+		t.Errorf("path, segmentAt should return a copy, got %v", path.GetAt(0))
+	}
+}
+
 func TestPathInsertAt(t *testing.T) {
 	path := NewPath()
 	point1 := NewPoint(1, 2)
@@ -716,6 +2096,88 @@ func TestPathRemoveAtPanic(t *testing.T) {
 	p.RemoveAt(2)
 }
 
+func TestPathRemoveRange(t *testing.T) {
+	path := NewPath()
+	for i := 0; i < 6; i++ {
+		path.Push(NewPoint(float64(i), 0))
+	}
+
+	path.RemoveRange(1, 4)
+
+	answer := NewPath()
+	answer.Push(NewPoint(0, 0))
+	answer.Push(NewPoint(4, 0))
+	answer.Push(NewPoint(5, 0))
+
+	if !path.Equals(answer) {
+		t.Errorf("path, remove range expected %v, got %v", answer.Points(), path.Points())
+	}
+
+	if path.RemoveRange(1, 1); path.Length() != 3 {
+		t.Errorf("path, remove empty range should not remove points, got %d", path.Length())
+	}
+
+	if path.RemoveRange(0, 3); path.Length() != 0 {
+		t.Errorf("path, remove full range should remove all points, got %d", path.Length())
+	}
+}
+
+func TestPathRemoveRangePanic(t *testing.T) {
+	ranges := [][3]int{{-1, 1, -1}, {0, 3, 3}, {2, 1, 2}}
+
+	for _, r := range ranges {
+		func() {
+			defer func() {
+				err, ok := recover().(*IndexError)
+				if !ok {
+					t.Fatal("path, expect removeRange to panic with an index error if range out of range")
+				}
+
+				if err.Op != "remove range" || err.Index != r[2] || err.Length != 2 {
+					t.Errorf("path, removeRange incorrect index error, got %+v", err)
+				}
+			}()
+
+			p := NewPath()
+			p.Push(NewPoint(1, 2))
+			p.Push(NewPoint(3, 4))
+			p.RemoveRange(r[0], r[1])
+		}()
+	}
+}
+
+func TestPathIdleSegments(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(10, 0))
+	p.Push(NewPoint(10.5, 0))
+	p.Push(NewPoint(10, 0.5))
+	p.Push(NewPoint(10.5, 0.5))
+	p.Push(NewPoint(11, 0.5)) // drifts away from the start but overlaps
+	p.Push(NewPoint(20, 0))
+	p.Push(NewPoint(30, 0))
+	p.Push(NewPoint(30, 0.1))
+	p.Push(NewPoint(30.1, 0))
+
+	expected := [][2]int{{1, 5}, {7, 9}}
+	if s := p.IdleSegments(1, 3); !reflect.DeepEqual(s, expected) {
+		t.Errorf("path, idleSegments expected %v, got %v", expected, s)
+	}
+
+	expected = [][2]int{{1, 5}}
+	if s := p.IdleSegments(1, 4); !reflect.DeepEqual(s, expected) {
+		t.Errorf("path, idleSegments expected %v, got %v", expected, s)
+	}
+
+	if s := p.IdleSegments(1, 6); len(s) != 0 {
+		t.Errorf("path, idleSegments expected none, got %v", s)
+	}
+
+	if s := NewPath().IdleSegments(1, 2); len(s) != 0 {
+		t.Errorf("path, idleSegments of empty path expected none, got %v", s)
+	}
+}
+
 func TestPathPush(t *testing.T) {
 	p := NewPath()
 	p.Push(NewPoint(1, 2))
@@ -770,6 +2232,57 @@ func TestPathEquals(t *testing.T) {
 	}
 }
 
+func TestPathEqualsIgnoringDirection(t *testing.T) {
+	p1 := NewPath()
+	p1.Push(NewPoint(0.5, .2))
+	p1.Push(NewPoint(-1, 0))
+	p1.Push(NewPoint(1, 10))
+
+	if !p1.EqualsIgnoringDirection(p1.Clone(), 0) {
+		t.Error("path, equal paths should be equal")
+	}
+
+	p2 := NewPath()
+	p2.Push(NewPoint(1, 10))
+	p2.Push(NewPoint(-1, 0))
+	p2.Push(NewPoint(0.5, .2))
+	if !p1.EqualsIgnoringDirection(p2, 0) {
+		t.Error("path, reversed paths should be equal")
+	}
+
+	if p1.Equals(p2) {
+		t.Error("path, reversed paths should not be strictly equal")
+	}
+
+	p2.SetAt(1, NewPoint(-1, 1e-7))
+	if p1.EqualsIgnoringDirection(p2, 0) {
+		t.Error("path, paths outside epsilon should not be equal")
+	}
+
+	if !p1.EqualsIgnoringDirection(p2, epsilon) {
+		t.Error("path, paths within epsilon should be equal")
+	}
+
+	p3 := p1.Clone().SetAt(0, NewPoint(0, 0))
+	if p1.EqualsIgnoringDirection(p3, epsilon) {
+		t.Error("path, different paths should not be equal")
+	}
+
+	p3 = p1.Clone()
+	p3.Pop()
+	if p1.EqualsIgnoringDirection(p3, epsilon) {
+		t.Error("path, paths of different length should not be equal")
+	}
+
+	if !NewPath().EqualsIgnoringDirection(NewPath(), 0) {
+		t.Error("path, empty paths should be equal")
+	}
+
+	if !p1.EqualsUndirected(p2, epsilon) || p1.EqualsUndirected(p3, epsilon) {
+		t.Error("path, equalsUndirected should match equalsIgnoringDirection")
+	}
+}
+
 func TestPathClone(t *testing.T) {
 	p1 := NewPath()
 	p1.Push(NewPoint(0, 0))
@@ -7,6 +7,7 @@ package geo
 import (
 	"fmt"
 	"math"
+	"sort"
 	"strconv"
 )
 
@@ -22,6 +23,17 @@ func NewPoint(x, y float64) *Point {
 	return &Point{x, y}
 }
 
+// NewPointFromCoords creates a new point from a [x, y] or [lng, lat] slice,
+// such as GeoJSON coordinates. Values after the first two, e.g. elevation, are ignored.
+// Panics if the slice has fewer than 2 values.
+func NewPointFromCoords(coords []float64) *Point {
+	if len(coords) < 2 {
+		panic(fmt.Sprintf("geo: point coordinates require 2 values, given %d", len(coords)))
+	}
+
+	return &Point{coords[0], coords[1]}
+}
+
 // NewPointFromQuadkey creates a new point from a quadkey.
 // See http://msdn.microsoft.com/en-us/library/bb259689.aspx for more information
 // about this coordinate system.
@@ -80,6 +92,13 @@ func (p *Point) SquaredDistanceFrom(point *Point) float64 {
 
 // GeoDistanceFrom returns the geodesic distance in meters.
 func (p *Point) GeoDistanceFrom(point *Point, haversine ...bool) float64 {
+	return p.GeoDistanceFromWithRadius(point, EarthRadius, haversine...)
+}
+
+// GeoDistanceFromWithRadius returns the geodesic distance on a sphere of the given radius.
+// The result is in the units of the radius. Useful for a one off computation
+// with a different radius without changing the global EarthRadius.
+func (p *Point) GeoDistanceFromWithRadius(point *Point, radius float64, haversine ...bool) float64 {
 	dLat := deg2rad(point.Lat() - p.Lat())
 	dLng := deg2rad(point.Lng() - p.Lng())
 
@@ -89,12 +108,73 @@ func (p *Point) GeoDistanceFrom(point *Point, haversine ...bool) float64 {
 		dLng2Sin := math.Sin(dLng / 2)
 		a := dLat2Sin*dLat2Sin + math.Cos(deg2rad(p.Lat()))*math.Cos(deg2rad(point.Lat()))*dLng2Sin*dLng2Sin
 
-		return 2.0 * EarthRadius * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+		return 2.0 * radius * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
 	}
 
 	// fast way using pythagorean theorem on an equirectangular projection
 	x := dLng * math.Cos(deg2rad((p.Lat()+point.Lat())/2.0))
-	return math.Sqrt(dLat*dLat+x*x) * EarthRadius
+	return math.Sqrt(dLat*dLat+x*x) * radius
+}
+
+// VincentyDistanceFrom returns the distance in meters between the lng/lat points on the
+// WGS84 ellipsoid using the Vincenty inverse formula. This is accurate to within a millimeter,
+// compared to up to 0.5% error for the spherical GeoDistanceFrom, but is much slower.
+// Returns ErrVincentyNoConvergence if the formula does not converge, e.g. for nearly antipodal points.
+func (p *Point) VincentyDistanceFrom(point *Point) (float64, error) {
+	const (
+		a = 6378137.0
+		f = 1 / 298.257223563
+		b = a * (1 - f)
+	)
+
+	L := deg2rad(point.Lng() - p.Lng())
+	U1 := math.Atan((1 - f) * math.Tan(deg2rad(p.Lat())))
+	U2 := math.Atan((1 - f) * math.Tan(deg2rad(point.Lat())))
+	sinU1, cosU1 := math.Sincos(U1)
+	sinU2, cosU2 := math.Sincos(U2)
+
+	var sinSigma, cosSigma, sigma, cosSqAlpha, cos2SigmaM float64
+
+	lambda := L
+	for i := 0; ; i++ {
+		if i == 100 {
+			return 0, ErrVincentyNoConvergence
+		}
+
+		sinLambda, cosLambda := math.Sincos(lambda)
+		x := cosU1*sinU2 - sinU1*cosU2*cosLambda
+		sinSigma = math.Sqrt(cosU2*sinLambda*cosU2*sinLambda + x*x)
+		if sinSigma == 0 {
+			return 0, nil // coincident points
+		}
+
+		cosSigma = sinU1*sinU2 + cosU1*cosU2*cosLambda
+		sigma = math.Atan2(sinSigma, cosSigma)
+
+		sinAlpha := cosU1 * cosU2 * sinLambda / sinSigma
+		cosSqAlpha = 1 - sinAlpha*sinAlpha
+
+		cos2SigmaM = 0 // equatorial line
+		if cosSqAlpha != 0 {
+			cos2SigmaM = cosSigma - 2*sinU1*sinU2/cosSqAlpha
+		}
+
+		C := f / 16 * cosSqAlpha * (4 + f*(4-3*cosSqAlpha))
+		prev := lambda
+		lambda = L + (1-C)*f*sinAlpha*(sigma+C*sinSigma*(cos2SigmaM+C*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+
+		if math.Abs(lambda-prev) < 1e-12 {
+			break
+		}
+	}
+
+	uSq := cosSqAlpha * (a*a - b*b) / (b * b)
+	A := 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+	B := uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+	deltaSigma := B * sinSigma * (cos2SigmaM + B/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+		B/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+
+	return b * A * (sigma - deltaSigma), nil
 }
 
 // BearingTo computes the direction one must start traveling on earth
@@ -111,6 +191,35 @@ func (p *Point) BearingTo(point *Point) float64 {
 	return rad2deg(math.Atan2(y, x))
 }
 
+// CrossTrackDistance returns the distance, in meters, of p from the great circle
+// through a and b. The result is negative if p is to the left of the direction of travel
+// from a to b and positive if to the right. Assumes lng/lat points.
+func CrossTrackDistance(p, a, b *Point) float64 {
+	d13 := a.GeoDistanceFrom(p, true) / EarthRadius
+	t13 := deg2rad(a.BearingTo(p))
+	t12 := deg2rad(a.BearingTo(b))
+
+	return math.Asin(math.Sin(d13)*math.Sin(t13-t12)) * EarthRadius
+}
+
+// AlongTrackDistance returns the distance, in meters, from a to the closest point
+// to p on the great circle through a and b. The result is negative if that point
+// is behind a, relative to the direction of b. Assumes lng/lat points.
+func AlongTrackDistance(p, a, b *Point) float64 {
+	d13 := a.GeoDistanceFrom(p, true) / EarthRadius
+	t13 := deg2rad(a.BearingTo(p))
+	t12 := deg2rad(a.BearingTo(b))
+
+	dxt := math.Asin(math.Sin(d13) * math.Sin(t13-t12))
+	dat := math.Acos(math.Max(-1, math.Min(1, math.Cos(d13)/math.Cos(dxt))))
+
+	if math.Cos(t12-t13) < 0 {
+		dat = -dat
+	}
+
+	return dat * EarthRadius
+}
+
 // Quadkey returns the quad key for the given point at the provided level.
 // See http://msdn.microsoft.com/en-us/library/bb259689.aspx for more information
 // about this coordinate system.
@@ -189,6 +298,11 @@ func (p *Point) GeoHashInt64(bits int) (hash int64) {
 	return
 }
 
+// Bound returns a zero area bound around the point.
+func (p *Point) Bound() *Bound {
+	return NewBound(p[0], p[0], p[1], p[1])
+}
+
 // Add a point to the given point.
 func (p *Point) Add(point *Point) *Point {
 	p[0] += point[0]
@@ -205,6 +319,43 @@ func (p *Point) Subtract(point *Point) *Point {
 	return p
 }
 
+// Add returns a new point that is the sum of the given points.
+// Unlike the Point.Add method, the inputs are not modified.
+func Add(a, b *Point) *Point {
+	return &Point{a[0] + b[0], a[1] + b[1]}
+}
+
+// Sub returns a new point that is a minus b.
+// Unlike the Point.Subtract method, the inputs are not modified.
+func Sub(a, b *Point) *Point {
+	return &Point{a[0] - b[0], a[1] - b[1]}
+}
+
+// Scale returns a new point with each component of p scaled by the factor.
+// Unlike the Point.Scale method, the input is not modified.
+func Scale(p *Point, factor float64) *Point {
+	return &Point{p[0] * factor, p[1] * factor}
+}
+
+// Lerp returns a new point that is the linear interpolation from this point
+// to the given point. t = 0 returns a copy of this point, t = 1 a copy of the given point.
+// The points are not modified, see LerpTo for the in place version.
+func (p *Point) Lerp(to *Point, t float64) *Point {
+	return &Point{
+		p[0] + t*(to[0]-p[0]),
+		p[1] + t*(to[1]-p[1]),
+	}
+}
+
+// LerpTo moves the point t of the way towards the given point, a linear interpolation.
+// Modifies the point.
+func (p *Point) LerpTo(to *Point, t float64) *Point {
+	p[0] += t * (to[0] - p[0])
+	p[1] += t * (to[1] - p[1])
+
+	return p
+}
+
 // Normalize treats the point as a vector and
 // scales it such that its distance from [0,0] is 1.
 func (p *Point) Normalize() *Point {
@@ -231,6 +382,37 @@ func (p *Point) Scale(factor float64) *Point {
 	return p
 }
 
+// SnapToGrid rounds each component of the point to the nearest multiple of cellSize.
+// Useful for quantizing coordinates before comparison or storage.
+// cellSize should be positive.
+func (p *Point) SnapToGrid(cellSize float64) *Point {
+	p[0] = math.Floor(p[0]/cellSize+0.5) * cellSize
+	p[1] = math.Floor(p[1]/cellSize+0.5) * cellSize
+
+	return p
+}
+
+// Round rounds each component of the point to the given number of decimal places.
+// Unlike SnapToGrid(0.000001), which can leave floating point noise, the result is
+// the float closest to the rounded decimal value, so it encodes to JSON as expected.
+// Halfway values are rounded away from zero, so positive and negative values round the same.
+func (p *Point) Round(decimals int) *Point {
+	factor := math.Pow10(decimals)
+
+	p[0] = math.Round(p[0]*factor) / factor
+	p[1] = math.Round(p[1]*factor) / factor
+
+	return p
+}
+
+// Truncate reduces the precision of the point to the given number of decimal places,
+// for example to coarsen locations for privacy. The components are rounded, see Round,
+// not truncated toward zero, so the error is symmetric. For lng/lat points at the equator
+// 1 decimal is about 11 km, 2 about 1.1 km, 3 about 110 m, 4 about 11 m and 5 about 1.1 m.
+func (p *Point) Truncate(decimals int) *Point {
+	return p.Round(decimals)
+}
+
 // Dot is just x1*x2 + y1*y2
 func (p *Point) Dot(v *Point) float64 {
 	return p[0]*v[0] + p[1]*v[1]
@@ -241,6 +423,11 @@ func (p Point) ToArray() [2]float64 {
 	return [2]float64(p)
 }
 
+// Coordinates returns the point as a new [x, y] or [lng, lat] slice.
+func (p Point) Coordinates() []float64 {
+	return []float64{p[0], p[1]}
+}
+
 // Clone creates a duplicate of the point.
 func (p Point) Clone() *Point {
 	return &p
@@ -255,6 +442,97 @@ func (p *Point) Equals(point *Point) bool {
 	return false
 }
 
+// Compare orders the points by X and then by Y. Returns -1 if the point is less than
+// the given point, 1 if greater and 0 if they are equal.
+func (p *Point) Compare(point *Point) int {
+	switch {
+	case p[0] < point[0]:
+		return -1
+	case p[0] > point[0]:
+		return 1
+	case p[1] < point[1]:
+		return -1
+	case p[1] > point[1]:
+		return 1
+	}
+
+	return 0
+}
+
+// SortPoints sorts the points in place using the ordering defined by Point.Compare.
+func SortPoints(points []Point) {
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].Compare(&points[j]) < 0
+	})
+}
+
+// DedupPoints collapses points within tolerance of each other into a single point.
+// Groups are built greedily in input order, each point joins the first group whose seed,
+// the first point of the group, is within tolerance, otherwise it starts a new group.
+// The representative of each group is the centroid of its points and they are returned
+// in the order the groups were started. Uses a grid of tolerance sized cells so only
+// nearby seeds are compared, for roughly O(n) performance.
+// A non-positive tolerance only collapses exact duplicates.
+func DedupPoints(points []Point, tolerance float64) []Point {
+	type group struct {
+		seed  Point
+		sum   Point
+		count int
+	}
+
+	var groups []*group
+	cells := make(map[[2]int64][]*group)
+
+	cell := func(p *Point) [2]int64 {
+		if tolerance <= 0 {
+			return [2]int64{int64(math.Float64bits(p[0])), int64(math.Float64bits(p[1]))}
+		}
+
+		return [2]int64{int64(math.Floor(p[0] / tolerance)), int64(math.Floor(p[1] / tolerance))}
+	}
+
+	for i := range points {
+		p := &points[i]
+		c := cell(p)
+
+		var found *group
+		if tolerance <= 0 {
+			if gs := cells[c]; len(gs) != 0 {
+				found = gs[0]
+			}
+		} else {
+		search:
+			for dx := int64(-1); dx <= 1; dx++ {
+				for dy := int64(-1); dy <= 1; dy++ {
+					for _, g := range cells[[2]int64{c[0] + dx, c[1] + dy}] {
+						if g.seed.DistanceFrom(p) <= tolerance {
+							found = g
+							break search
+						}
+					}
+				}
+			}
+		}
+
+		if found == nil {
+			found = &group{seed: *p}
+			groups = append(groups, found)
+			cells[c] = append(cells[c], found)
+		}
+
+		found.sum[0] += p[0]
+		found.sum[1] += p[1]
+		found.count++
+	}
+
+	result := make([]Point, len(groups))
+	for i, g := range groups {
+		result[i] = Point{g.sum[0] / float64(g.count), g.sum[1] / float64(g.count)}
+	}
+
+	return result
+}
+
 // Lat returns the latitude/vertical component of the point.
 func (p *Point) Lat() float64 {
 	return p[1]
@@ -2,6 +2,7 @@ package geo
 
 import (
 	"math"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -142,6 +143,48 @@ func TestPointGeoDistanceFrom(t *testing.T) {
 	// TODO: implement this test
 }
 
+func TestPointGeoDistanceFromWithRadius(t *testing.T) {
+	p1 := NewPoint(0, 0)
+	p2 := NewPoint(0, 90)
+
+	for _, haversine := range []bool{true, false} {
+		if d := p1.GeoDistanceFromWithRadius(p2, 2, haversine); math.Abs(d-math.Pi) > epsilon {
+			t.Errorf("point, geoDistanceFromWithRadius expected %f, got %f", math.Pi, d)
+		}
+
+		expected := p1.GeoDistanceFrom(p2, haversine)
+		if d := p1.GeoDistanceFromWithRadius(p2, EarthRadius, haversine); d != expected {
+			t.Errorf("point, geoDistanceFromWithRadius expected %f, got %f", expected, d)
+		}
+	}
+}
+
+func TestPointVincentyDistanceFrom(t *testing.T) {
+	// Flinders Peak to Buninyong, from Vincenty's paper
+	p1 := NewPoint(144.42486788888889, -37.95103341666667)
+	p2 := NewPoint(143.92649552777777, -37.65282113888889)
+
+	d, err := p1.VincentyDistanceFrom(p2)
+	if err != nil || math.Abs(d-54972.271) > 0.001 {
+		t.Errorf("point, vincentyDistanceFrom expected 54972.271, got %f, %v", d, err)
+	}
+
+	if d, err := p1.VincentyDistanceFrom(p1); err != nil || d != 0 {
+		t.Errorf("point, vincentyDistanceFrom same point expected 0, got %f, %v", d, err)
+	}
+
+	// along the equator
+	d, err = NewPoint(0, 0).VincentyDistanceFrom(NewPoint(1, 0))
+	if expected := deg2rad(1) * 6378137.0; err != nil || math.Abs(d-expected) > 0.001 {
+		t.Errorf("point, vincentyDistanceFrom expected %f, got %f, %v", expected, d, err)
+	}
+
+	// nearly antipodal
+	if _, err := NewPoint(0, 0).VincentyDistanceFrom(NewPoint(179.7, 0.5)); err != ErrVincentyNoConvergence {
+		t.Errorf("point, vincentyDistanceFrom expected no convergence error, got %v", err)
+	}
+}
+
 func TestPointBearingTo(t *testing.T) {
 	p1 := NewPoint(0, 0)
 	p2 := NewPoint(0, 1)
@@ -166,6 +209,45 @@ func TestPointBearingTo(t *testing.T) {
 	}
 }
 
+func TestCrossTrackDistance(t *testing.T) {
+	a := NewPoint(0, 0)
+	b := NewPoint(10, 0)
+	degree := deg2rad(1) * EarthRadius
+
+	// left of the direction of travel
+	if d := CrossTrackDistance(NewPoint(5, 1), a, b); math.Abs(d+degree) > 1 {
+		t.Errorf("point, crossTrackDistance expected %f, got %f", -degree, d)
+	}
+
+	// right of the direction of travel
+	if d := CrossTrackDistance(NewPoint(5, -1), a, b); math.Abs(d-degree) > 1 {
+		t.Errorf("point, crossTrackDistance expected %f, got %f", degree, d)
+	}
+
+	if d := CrossTrackDistance(NewPoint(5, 0), a, b); math.Abs(d) > 1 {
+		t.Errorf("point, crossTrackDistance expected 0, got %f", d)
+	}
+}
+
+func TestAlongTrackDistance(t *testing.T) {
+	a := NewPoint(0, 0)
+	b := NewPoint(10, 0)
+	degree := deg2rad(1) * EarthRadius
+
+	if d := AlongTrackDistance(NewPoint(5, 1), a, b); math.Abs(d-5*degree) > 1 {
+		t.Errorf("point, alongTrackDistance expected %f, got %f", 5*degree, d)
+	}
+
+	if d := AlongTrackDistance(NewPoint(5, -2), a, b); math.Abs(d-5*degree) > 1 {
+		t.Errorf("point, alongTrackDistance expected %f, got %f", 5*degree, d)
+	}
+
+	// behind the start
+	if d := AlongTrackDistance(NewPoint(-3, 1), a, b); math.Abs(d+3*degree) > 1 {
+		t.Errorf("point, alongTrackDistance expected %f, got %f", -3*degree, d)
+	}
+}
+
 func TestPointAddSubtract(t *testing.T) {
 	var answer *Point
 	p1 := NewPoint(1, 2)
@@ -232,6 +314,157 @@ func TestPointScale(t *testing.T) {
 	}
 }
 
+func TestPointBound(t *testing.T) {
+	p := NewPoint(1, 2)
+
+	answer := NewBound(1, 1, 2, 2)
+	if b := p.Bound(); !b.Equals(answer) {
+		t.Errorf("point, bound expected %v, got %v", answer, b)
+	}
+}
+
+func TestAdd(t *testing.T) {
+	a := NewPoint(1, 2)
+	b := NewPoint(3, 5)
+
+	answer := NewPoint(4, 7)
+	if p := Add(a, b); !p.Equals(answer) {
+		t.Errorf("add expect %v == %v", p, answer)
+	}
+
+	if !a.Equals(NewPoint(1, 2)) || !b.Equals(NewPoint(3, 5)) {
+		t.Error("add should not modify the inputs")
+	}
+
+	if p := Add(a, a); p == a {
+		t.Error("add should return a new point")
+	}
+}
+
+func TestSub(t *testing.T) {
+	a := NewPoint(1, 2)
+	b := NewPoint(3, 5)
+
+	answer := NewPoint(-2, -3)
+	if p := Sub(a, b); !p.Equals(answer) {
+		t.Errorf("sub expect %v == %v", p, answer)
+	}
+
+	if !a.Equals(NewPoint(1, 2)) || !b.Equals(NewPoint(3, 5)) {
+		t.Error("sub should not modify the inputs")
+	}
+}
+
+func TestScale(t *testing.T) {
+	a := NewPoint(1, 2)
+
+	answer := NewPoint(-3, -6)
+	if p := Scale(a, -3); !p.Equals(answer) {
+		t.Errorf("scale expect %v == %v", p, answer)
+	}
+
+	if !a.Equals(NewPoint(1, 2)) {
+		t.Error("scale should not modify the input")
+	}
+}
+
+func TestPointLerp(t *testing.T) {
+	p1 := NewPoint(0, 0)
+	p2 := NewPoint(4, 8)
+
+	answer := NewPoint(1, 2)
+	if p := p1.Lerp(p2, 0.25); !p.Equals(answer) {
+		t.Errorf("point, lerp expect %v == %v", p, answer)
+	}
+
+	if p := p1.Lerp(p2, 0); !p.Equals(p1) || p == p1 {
+		t.Errorf("point, lerp 0 should be a copy of the start, got %v", p)
+	}
+
+	if p := p1.Lerp(p2, 1); !p.Equals(p2) {
+		t.Errorf("point, lerp 1 should be the end, got %v", p)
+	}
+
+	if !p1.Equals(NewPoint(0, 0)) || !p2.Equals(NewPoint(4, 8)) {
+		t.Error("point, lerp should not modify the points")
+	}
+}
+
+func TestPointLerpTo(t *testing.T) {
+	p := NewPoint(0, 0)
+
+	answer := NewPoint(2, 4)
+	if p.LerpTo(NewPoint(4, 8), 0.5); !p.Equals(answer) {
+		t.Errorf("point, lerpTo expect %v == %v", p, answer)
+	}
+
+	answer = NewPoint(0, 0)
+	if p.LerpTo(NewPoint(4, 8), -1); !p.Equals(answer) {
+		t.Errorf("point, lerpTo expect %v == %v", p, answer)
+	}
+}
+
+func TestPointRound(t *testing.T) {
+	p := NewPoint(-122.41670049, 37.8870000001).Round(6)
+	if p[0] != -122.4167 || p[1] != 37.887 {
+		t.Errorf("point, round expected exact decimal values, got %v", p)
+	}
+
+	p = NewPoint(1.25, -1.24).Round(1)
+	if p[0] != 1.3 || p[1] != -1.2 {
+		t.Errorf("point, round incorrect, got %v", p)
+	}
+
+	p = NewPoint(1234.5, 2.4).Round(0)
+	if p[0] != 1235 || p[1] != 2 {
+		t.Errorf("point, round to integers incorrect, got %v", p)
+	}
+
+	// ties should round symmetrically
+	p = NewPoint(-1.25, -1234.5)
+	if p.Round(1); p[0] != -1.3 {
+		t.Errorf("point, round negative tie incorrect, got %v", p)
+	}
+
+	if p.Round(0); p[1] != -1235 {
+		t.Errorf("point, round negative tie to integer incorrect, got %v", p)
+	}
+}
+
+func TestPointTruncate(t *testing.T) {
+	p := NewPoint(-122.41678, 37.88721).Truncate(3)
+	if p[0] != -122.417 || p[1] != 37.887 {
+		t.Errorf("point, truncate should round, got %v", p)
+	}
+
+	p = NewPoint(1.25, -1.25).Truncate(1)
+	if p[0] != 1.3 || p[1] != -1.3 {
+		t.Errorf("point, truncate should be symmetric, got %v", p)
+	}
+}
+
+func TestPointSnapToGrid(t *testing.T) {
+	var p, answer *Point
+
+	p = NewPoint(1.2, 3.7)
+	answer = NewPoint(1, 4)
+	if p.SnapToGrid(1.0); !p.Equals(answer) {
+		t.Errorf("point, snap to grid expect %v == %v", p, answer)
+	}
+
+	p = NewPoint(-1.2, -3.7)
+	answer = NewPoint(-1, -4)
+	if p.SnapToGrid(1.0); !p.Equals(answer) {
+		t.Errorf("point, snap to grid expect %v == %v", p, answer)
+	}
+
+	p = NewPoint(7, 13)
+	answer = NewPoint(5, 15)
+	if p.SnapToGrid(5.0); !p.Equals(answer) {
+		t.Errorf("point, snap to grid expect %v == %v", p, answer)
+	}
+}
+
 func TestDot(t *testing.T) {
 	p1 := NewPoint(0, 0)
 
@@ -287,6 +520,82 @@ func TestPointClone(t *testing.T) {
 	}
 }
 
+func TestPointCompare(t *testing.T) {
+	p := NewPoint(1, 2)
+
+	type testCase struct {
+		point  *Point
+		result int
+	}
+
+	tests := []testCase{
+		{NewPoint(1, 2), 0},
+		{NewPoint(2, 0), -1},
+		{NewPoint(0, 5), 1},
+		{NewPoint(1, 3), -1},
+		{NewPoint(1, 1), 1},
+	}
+
+	for i, test := range tests {
+		if r := p.Compare(test.point); r != test.result {
+			t.Errorf("point, compare %d expected %d, got %d", i, test.result, r)
+		}
+
+		if r := test.point.Compare(p); r != -test.result {
+			t.Errorf("point, compare %d reversed expected %d, got %d", i, -test.result, r)
+		}
+	}
+}
+
+func TestSortPoints(t *testing.T) {
+	points := []Point{{1, 2}, {0, 5}, {1, 1}, {-1, 3}, {1, 2}}
+	SortPoints(points)
+
+	expected := []Point{{-1, 3}, {0, 5}, {1, 1}, {1, 2}, {1, 2}}
+	if !reflect.DeepEqual(points, expected) {
+		t.Errorf("point, sort expected %v, got %v", expected, points)
+	}
+}
+
+func TestDedupPoints(t *testing.T) {
+	points := []Point{
+		{0, 0},
+		{10, 10},
+		{0.5, 0},
+		{0, 0.5},
+		{10.2, 10},
+		{3, 3},
+		{-0.5, -0.4}, // in a different cell from the seed
+	}
+
+	expected := []Point{
+		{0, 0.025},
+		{10.1, 10},
+		{3, 3},
+	}
+
+	result := DedupPoints(points, 1)
+	if len(result) != len(expected) {
+		t.Fatalf("point, dedup expected %v, got %v", expected, result)
+	}
+
+	for i := range expected {
+		if result[i].DistanceFrom(&expected[i]) > epsilon {
+			t.Errorf("point, dedup expected %v, got %v", expected[i], result[i])
+		}
+	}
+
+	// exact duplicates only
+	result = DedupPoints([]Point{{1, 2}, {1, 2}, {1, 2.1}}, 0)
+	if !reflect.DeepEqual(result, []Point{{1, 2}, {1, 2.1}}) {
+		t.Errorf("point, dedup with zero tolerance incorrect, got %v", result)
+	}
+
+	if result := DedupPoints(nil, 1); len(result) != 0 {
+		t.Errorf("point, dedup of no points should be empty, got %v", result)
+	}
+}
+
 func TestPointEquals(t *testing.T) {
 	p1 := NewPoint(1, 0)
 	p2 := NewPoint(1, 0)
@@ -358,6 +667,38 @@ func TestPointToArray(t *testing.T) {
 	}
 }
 
+func TestPointCoordinates(t *testing.T) {
+	p := NewPoint(1, 2)
+
+	c := p.Coordinates()
+	if !reflect.DeepEqual(c, []float64{1, 2}) {
+		t.Errorf("point, coordinates expected %v, got %v", []float64{1, 2}, c)
+	}
+
+	c[0] = 5
+	if p[0] != 1 {
+		t.Error("point, coordinates should be a copy")
+	}
+
+	if p := NewPointFromCoords([]float64{1, 2}); !p.Equals(NewPoint(1, 2)) {
+		t.Errorf("point, from coords incorrect, got %v", p)
+	}
+
+	if p := NewPointFromCoords([]float64{1, 2, 100}); !p.Equals(NewPoint(1, 2)) {
+		t.Errorf("point, from coords should ignore extra values, got %v", p)
+	}
+}
+
+func TestNewPointFromCoordsPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("point, expect from coords to panic if too few values")
+		}
+	}()
+
+	NewPointFromCoords([]float64{1})
+}
+
 func TestPointString(t *testing.T) {
 	p := NewPoint(1, 2)
 
@@ -0,0 +1,542 @@
+package geo
+
+import (
+	"fmt"
+	"math"
+)
+
+// A Polygon is an area defined by an outer ring and zero or more inner rings, or holes.
+// The rings are paths that are expected to be closed, i.e. the first and last points are equal.
+type Polygon struct {
+	outer *Path
+	holes []*Path
+}
+
+// NewPolygon creates a new polygon from the outer ring and any holes.
+// The rings are not copied.
+func NewPolygon(outer *Path, holes ...*Path) *Polygon {
+	return &Polygon{
+		outer: outer,
+		holes: holes,
+	}
+}
+
+// Outer returns the outer ring of the polygon.
+func (p *Polygon) Outer() *Path {
+	return p.outer
+}
+
+// Holes returns the inner rings of the polygon.
+func (p *Polygon) Holes() []*Path {
+	return p.holes
+}
+
+// Transform applies a given projection or inverse projection to all
+// the rings of the polygon. Modifies the polygon.
+func (p *Polygon) Transform(projector Projector) *Polygon {
+	p.outer.Transform(projector)
+	for _, h := range p.holes {
+		h.Transform(projector)
+	}
+
+	return p
+}
+
+// Bound returns a bound around the polygon, which is the bound of the outer ring.
+func (p *Polygon) Bound() *Bound {
+	return p.outer.Bound()
+}
+
+// ClosestBoundaryPoint returns the point on the outer ring or any of the holes
+// nearest the given point, and the Euclidean distance to it. Unlike Contains this
+// considers the boundary only, so points inside the polygon have a positive distance.
+// Returns nil and +Inf if the polygon has no points.
+func (p *Polygon) ClosestBoundaryPoint(point *Point) (*Point, float64) {
+	var closest *Point
+	minDistance := math.Inf(1)
+
+	for _, ring := range p.rings() {
+		c, ok := ring.ClosestPointWithin(point, minDistance)
+		if !ok {
+			continue
+		}
+
+		if d := c.DistanceFrom(point); d < minDistance || closest == nil {
+			closest = c
+			minDistance = d
+		}
+	}
+
+	return closest, minDistance
+}
+
+// Equals compares two polygons. Returns true if the outer rings
+// and all the holes, in order, are Equal.
+func (p *Polygon) Equals(polygon *Polygon) bool {
+	if len(p.holes) != len(polygon.holes) {
+		return false
+	}
+
+	if !p.outer.Equals(polygon.outer) {
+		return false
+	}
+
+	for i, h := range p.holes {
+		if !h.Equals(polygon.holes[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of the polygon.
+func (p *Polygon) Clone() *Polygon {
+	holes := make([]*Path, len(p.holes))
+	for i, h := range p.holes {
+		holes[i] = h.Clone()
+	}
+
+	return &Polygon{
+		outer: p.outer.Clone(),
+		holes: holes,
+	}
+}
+
+// Validate checks the polygon for common problems and returns an error for each one found.
+// Rings are numbered with the outer ring as 0 and the holes starting at 1.
+// Checks that rings are closed, have enough points, have no duplicate consecutive points,
+// are wound correctly, counter-clockwise for the outer ring and clockwise for holes,
+// and do not self-intersect. Returns an empty slice for valid polygons.
+func (p *Polygon) Validate() []error {
+	errs := []error{}
+
+	for i, ring := range p.rings() {
+		points := ring.points
+
+		if len(points) > 0 && !points[0].Equals(&points[len(points)-1]) {
+			errs = append(errs, fmt.Errorf("geo: polygon ring %d is not closed", i))
+		}
+
+		open := openRing(points)
+		if len(open) < 3 {
+			errs = append(errs, fmt.Errorf("geo: polygon ring %d has too few points, %d", i, len(open)))
+			continue
+		}
+
+		for j := 1; j < len(points); j++ {
+			if points[j].Equals(&points[j-1]) {
+				errs = append(errs, fmt.Errorf("geo: polygon ring %d has duplicate consecutive points at %d", i, j))
+				break
+			}
+		}
+
+		area := ringSignedArea(open)
+		if i == 0 && area < 0 {
+			errs = append(errs, fmt.Errorf("geo: polygon outer ring is not counter-clockwise"))
+		} else if i != 0 && area > 0 {
+			errs = append(errs, fmt.Errorf("geo: polygon ring %d, a hole, is not clockwise", i))
+		}
+
+		// duplicate points would look like self-intersections
+		if ringSelfIntersects(openRing(removeDuplicates(points))) {
+			errs = append(errs, fmt.Errorf("geo: polygon ring %d self-intersects", i))
+		}
+	}
+
+	return errs
+}
+
+// IsValid checks the polygon against the OGC simple feature rules, the same rules
+// PostGIS uses for ST_IsValid. Returns false and the reason for the first problem found.
+// Rings are numbered the same as Validate. Unlike Validate, winding order and
+// duplicate consecutive points are allowed. Rings touching at a point are considered invalid.
+func (p *Polygon) IsValid() (bool, string) {
+	rings := p.rings()
+
+	open := make([][]Point, len(rings))
+	for i, ring := range rings {
+		points := ring.points
+
+		if len(points) == 0 || !points[0].Equals(&points[len(points)-1]) {
+			return false, fmt.Sprintf("ring %d is not closed", i)
+		}
+
+		open[i] = openRing(removeDuplicates(points))
+		if len(open[i]) < 3 {
+			return false, fmt.Sprintf("ring %d has too few points", i)
+		}
+
+		if ringSelfIntersects(open[i]) {
+			return false, fmt.Sprintf("ring %d self-intersects", i)
+		}
+	}
+
+	for i := 1; i < len(open); i++ {
+		if ringsIntersect(open[0], open[i]) {
+			return false, fmt.Sprintf("ring %d intersects the outer ring", i)
+		}
+
+		if !ringContains(open[0], &open[i][0]) {
+			return false, fmt.Sprintf("ring %d is outside the outer ring", i)
+		}
+
+		for j := 1; j < i; j++ {
+			if ringsIntersect(open[i], open[j]) {
+				return false, fmt.Sprintf("ring %d intersects ring %d", i, j)
+			}
+
+			if ringContains(open[j], &open[i][0]) || ringContains(open[i], &open[j][0]) {
+				return false, fmt.Sprintf("ring %d and ring %d are nested", j, i)
+			}
+		}
+	}
+
+	return true, ""
+}
+
+// Repair fixes the problems that can be fixed without changing the shape of the polygon.
+// It closes the rings, removes duplicate consecutive points and fixes the winding order
+// to be counter-clockwise for the outer ring and clockwise for holes.
+// Self-intersections are not repaired. Modifies the polygon.
+func (p *Polygon) Repair() *Polygon {
+	for i, ring := range p.rings() {
+		if len(ring.points) == 0 {
+			continue
+		}
+
+		points := removeDuplicates(ring.points)
+		if !points[0].Equals(&points[len(points)-1]) {
+			points = append(points, points[0])
+		}
+
+		area := ringSignedArea(openRing(points))
+		if (i == 0 && area < 0) || (i != 0 && area > 0) {
+			for l, r := 0, len(points)-1; l < r; l, r = l+1, r-1 {
+				points[l], points[r] = points[r], points[l]
+			}
+		}
+
+		ring.points = points
+	}
+
+	return p
+}
+
+// PolygonFromRings assembles a polygon from a flat list of rings, such as those read from
+// WKT or GeoJSON, by classifying them as the outer ring or holes. The ring with the largest
+// area is the outer ring, preferring counter-clockwise rings if tied, and rings with their
+// first point inside the outer ring are holes. Other rings are ignored. The rings are copied
+// and repaired, so the result has the correct winding order. Returns nil if no rings are given.
+func PolygonFromRings(rings []*Path) *Polygon {
+	if len(rings) == 0 {
+		return nil
+	}
+
+	outer := 0
+	maxArea := ringSignedArea(openRing(rings[0].points))
+	for i, r := range rings[1:] {
+		area := ringSignedArea(openRing(r.points))
+		if math.Abs(area) > math.Abs(maxArea) ||
+			(math.Abs(area) == math.Abs(maxArea) && area > maxArea) {
+			outer = i + 1
+			maxArea = area
+		}
+	}
+
+	p := &Polygon{outer: rings[outer].Clone()}
+	for i, r := range rings {
+		if i == outer || len(r.points) == 0 {
+			continue
+		}
+
+		if ringContains(p.outer.points, &r.points[0]) {
+			p.holes = append(p.holes, r.Clone())
+		}
+	}
+
+	return p.Repair()
+}
+
+// rings returns the outer ring followed by all the holes.
+func (p *Polygon) rings() []*Path {
+	rings := make([]*Path, 0, len(p.holes)+1)
+	rings = append(rings, p.outer)
+	return append(rings, p.holes...)
+}
+
+// Intersection computes the overlap of the two polygons using the Greiner-Hormann
+// clipping algorithm. Returns the, possibly multiple, polygons that make up the overlap,
+// an empty slice if the polygons do not intersect, or a copy of the inner polygon
+// if one is completely contained in the other. Only the outer rings are clipped, holes
+// are ignored. Degenerate cases, where a vertex lies exactly on the other polygon's edge,
+// are not handled and may produce incorrect results.
+func (p *Polygon) Intersection(polygon *Polygon) []*Polygon {
+	subject := openRing(p.outer.points)
+	clip := openRing(polygon.outer.points)
+
+	if len(subject) < 3 || len(clip) < 3 {
+		return []*Polygon{}
+	}
+
+	subjectList, clipList, found := ghBuildLists(subject, clip)
+	if !found {
+		if ringContains(clip, &subject[0]) {
+			return []*Polygon{p.Clone()}
+		}
+
+		if ringContains(subject, &clip[0]) {
+			return []*Polygon{polygon.Clone()}
+		}
+
+		return []*Polygon{}
+	}
+
+	ghMarkEntries(subjectList, clip)
+	ghMarkEntries(clipList, subject)
+
+	var result []*Polygon
+	for v := subjectList; ; v = v.next {
+		if v.intersect && !v.visited {
+			result = append(result, NewPolygon(ghTraverse(v)))
+		}
+
+		if v.next == subjectList {
+			break
+		}
+	}
+
+	return result
+}
+
+// ghVertex is a node in the circular doubly linked lists used by the
+// Greiner-Hormann clipping algorithm.
+type ghVertex struct {
+	point      Point
+	next, prev *ghVertex
+	neighbor   *ghVertex // the same intersection in the other list
+	alpha      float64   // position of an intersection along its edge
+	intersect  bool
+	entry      bool
+	visited    bool
+}
+
+// ghBuildLists creates the circular vertex lists for the two rings
+// with the intersection points inserted, in order, along each edge.
+func ghBuildLists(subject, clip []Point) (*ghVertex, *ghVertex, bool) {
+	subjectEdges := make([][]*ghVertex, len(subject))
+	clipEdges := make([][]*ghVertex, len(clip))
+
+	found := false
+	for i := range subject {
+		s1, s2 := subject[i], subject[(i+1)%len(subject)]
+		sx, sy := s2[0]-s1[0], s2[1]-s1[1]
+
+		for j := range clip {
+			c1, c2 := clip[j], clip[(j+1)%len(clip)]
+			cx, cy := c2[0]-c1[0], c2[1]-c1[1]
+
+			den := sx*cy - sy*cx
+			if den == 0 {
+				continue
+			}
+
+			dx, dy := c1[0]-s1[0], c1[1]-s1[1]
+			alphaS := (dx*cy - dy*cx) / den
+			alphaC := (dx*sy - dy*sx) / den
+
+			if alphaS <= 0 || alphaS >= 1 || alphaC <= 0 || alphaC >= 1 {
+				continue
+			}
+
+			point := Point{s1[0] + alphaS*sx, s1[1] + alphaS*sy}
+			vs := &ghVertex{point: point, alpha: alphaS, intersect: true}
+			vc := &ghVertex{point: point, alpha: alphaC, intersect: true}
+			vs.neighbor = vc
+			vc.neighbor = vs
+
+			subjectEdges[i] = append(subjectEdges[i], vs)
+			clipEdges[j] = append(clipEdges[j], vc)
+			found = true
+		}
+	}
+
+	return ghLink(subject, subjectEdges), ghLink(clip, clipEdges), found
+}
+
+// ghLink builds a circular list of the ring points with the intersections of
+// each edge inserted after the edge's start point, sorted by alpha.
+func ghLink(ring []Point, edges [][]*ghVertex) *ghVertex {
+	var first, last *ghVertex
+	add := func(v *ghVertex) {
+		if first == nil {
+			first = v
+		} else {
+			last.next = v
+			v.prev = last
+		}
+		last = v
+	}
+
+	for i := range ring {
+		add(&ghVertex{point: ring[i]})
+
+		// insertion sort, there are usually very few intersections per edge
+		intersections := edges[i]
+		for a := 1; a < len(intersections); a++ {
+			for b := a; b > 0 && intersections[b].alpha < intersections[b-1].alpha; b-- {
+				intersections[b], intersections[b-1] = intersections[b-1], intersections[b]
+			}
+		}
+
+		for _, v := range intersections {
+			add(v)
+		}
+	}
+
+	last.next = first
+	first.prev = last
+
+	return first
+}
+
+// ghMarkEntries labels each intersection as entering or exiting the other ring
+// when traveling forward along the list.
+func ghMarkEntries(list *ghVertex, other []Point) {
+	entry := !ringContains(other, &list.point)
+	for v := list; ; v = v.next {
+		if v.intersect {
+			v.entry = entry
+			entry = !entry
+		}
+
+		if v.next == list {
+			break
+		}
+	}
+}
+
+// ghTraverse walks the lists starting at the given intersection, switching
+// between the two polygons at every intersection, to build one closed result ring.
+func ghTraverse(start *ghVertex) *Path {
+	ring := NewPath()
+	ring.Push(&start.point)
+
+	v := start
+	for {
+		v.visited = true
+		v.neighbor.visited = true
+
+		if v.entry {
+			for v = v.next; !v.intersect; v = v.next {
+				ring.Push(&v.point)
+			}
+		} else {
+			for v = v.prev; !v.intersect; v = v.prev {
+				ring.Push(&v.point)
+			}
+		}
+
+		ring.Push(&v.point)
+		v = v.neighbor
+
+		if v.visited {
+			break
+		}
+	}
+
+	return ring
+}
+
+// removeDuplicates returns a copy of the points with consecutive duplicates removed.
+func removeDuplicates(points []Point) []Point {
+	if len(points) == 0 {
+		return []Point{}
+	}
+
+	result := make([]Point, 1, len(points)+1)
+	result[0] = points[0]
+	for i := 1; i < len(points); i++ {
+		if !points[i].Equals(&result[len(result)-1]) {
+			result = append(result, points[i])
+		}
+	}
+
+	return result
+}
+
+// ringSignedArea returns the area of the open ring using the shoelace formula.
+// The area is positive if the ring is counter-clockwise and negative if clockwise.
+func ringSignedArea(ring []Point) float64 {
+	area := 0.0
+
+	j := len(ring) - 1
+	for i := range ring {
+		area += (ring[j][0] - ring[i][0]) * (ring[j][1] + ring[i][1])
+		j = i
+	}
+
+	return area / 2.0
+}
+
+// ringSelfIntersects checks if any two non-adjacent edges of the open ring intersect.
+func ringSelfIntersects(ring []Point) bool {
+	n := len(ring)
+	for i := 0; i < n; i++ {
+		l1 := NewLine(&ring[i], &ring[(i+1)%n])
+
+		for j := i + 2; j < n; j++ {
+			if i == 0 && j == n-1 {
+				continue // adjacent through the closing edge
+			}
+
+			if l1.Intersects(NewLine(&ring[j], &ring[(j+1)%n])) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ringsIntersect checks if any edges of the two open rings intersect.
+func ringsIntersect(a, b []Point) bool {
+	for i := range a {
+		l1 := NewLine(&a[i], &a[(i+1)%len(a)])
+
+		for j := range b {
+			if l1.Intersects(NewLine(&b[j], &b[(j+1)%len(b)])) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// openRing returns the points of the ring without the repeated closing point.
+func openRing(points []Point) []Point {
+	if len(points) > 1 && points[0].Equals(&points[len(points)-1]) {
+		return points[:len(points)-1]
+	}
+
+	return points
+}
+
+// ringContains uses the even-odd rule to determine if the point is
+// inside the ring. The ring may be open or closed.
+func ringContains(ring []Point, point *Point) bool {
+	in := false
+
+	j := len(ring) - 1
+	for i := range ring {
+		a, b := ring[i], ring[j]
+		if (a[1] > point[1]) != (b[1] > point[1]) &&
+			point[0] < (b[0]-a[0])*(point[1]-a[1])/(b[1]-a[1])+a[0] {
+			in = !in
+		}
+		j = i
+	}
+
+	return in
+}
@@ -0,0 +1,364 @@
+package geo
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func testSquare(x, y, size float64) *Path {
+	p := NewPath()
+	p.Push(NewPoint(x, y))
+	p.Push(NewPoint(x+size, y))
+	p.Push(NewPoint(x+size, y+size))
+	p.Push(NewPoint(x, y+size))
+	p.Push(NewPoint(x, y))
+
+	return p
+}
+
+func TestNewPolygon(t *testing.T) {
+	outer := testSquare(0, 0, 10)
+	hole := testSquare(2, 2, 2)
+
+	p := NewPolygon(outer, hole)
+	if p.Outer() != outer {
+		t.Error("polygon, outer ring should be the one given")
+	}
+
+	if len(p.Holes()) != 1 || p.Holes()[0] != hole {
+		t.Error("polygon, holes should be the ones given")
+	}
+
+	p = NewPolygon(outer)
+	if len(p.Holes()) != 0 {
+		t.Errorf("polygon, expected no holes, got %d", len(p.Holes()))
+	}
+}
+
+func TestPolygonTransform(t *testing.T) {
+	p := NewPolygon(testSquare(0, 0, 10), testSquare(2, 2, 2))
+	p.Transform(func(p *Point) { p.Scale(2) })
+
+	answer := NewPolygon(testSquare(0, 0, 20), testSquare(4, 4, 4))
+	if !p.Equals(answer) {
+		t.Errorf("polygon, transform expected %v, got %v", answer, p)
+	}
+}
+
+func TestPolygonBound(t *testing.T) {
+	p := NewPolygon(testSquare(1, 2, 10), testSquare(2, 3, 2))
+
+	answer := NewBound(1, 11, 2, 12)
+	if b := p.Bound(); !b.Equals(answer) {
+		t.Errorf("polygon, bound expected %v, got %v", answer, b)
+	}
+}
+
+func TestPolygonClosestBoundaryPoint(t *testing.T) {
+	p := NewPolygon(testSquare(0, 0, 10), testSquare(4, 4, 2))
+
+	type testCase struct {
+		point    *Point
+		closest  *Point
+		distance float64
+	}
+
+	tests := []testCase{
+		{NewPoint(1, 5), NewPoint(0, 5), 1},     // inside, near the outer ring
+		{NewPoint(3, 5), NewPoint(4, 5), 1},     // inside, near the hole
+		{NewPoint(5, 5.5), NewPoint(5, 6), 0.5}, // inside the hole
+		{NewPoint(12, 5), NewPoint(10, 5), 2},   // outside
+		{NewPoint(10, 3), NewPoint(10, 3), 0},   // on the boundary
+	}
+
+	for i, test := range tests {
+		c, d := p.ClosestBoundaryPoint(test.point)
+		if !c.Equals(test.closest) || math.Abs(d-test.distance) > epsilon {
+			t.Errorf("polygon, closestBoundaryPoint %d expected %v %f, got %v %f", i, test.closest, test.distance, c, d)
+		}
+	}
+
+	if c, d := NewPolygon(NewPath()).ClosestBoundaryPoint(NewPoint(1, 1)); c != nil || !math.IsInf(d, 1) {
+		t.Errorf("polygon, closestBoundaryPoint of empty polygon expected nil, got %v %f", c, d)
+	}
+}
+
+func TestPolygonEquals(t *testing.T) {
+	p1 := NewPolygon(testSquare(0, 0, 10), testSquare(2, 2, 2))
+	p2 := NewPolygon(testSquare(0, 0, 10), testSquare(2, 2, 2))
+
+	if !p1.Equals(p2) || !p2.Equals(p1) {
+		t.Error("polygon, equal polygons should be equal")
+	}
+
+	p2 = NewPolygon(testSquare(0, 0, 10))
+	if p1.Equals(p2) || p2.Equals(p1) {
+		t.Error("polygon, polygons with different holes should not be equal")
+	}
+
+	p2 = NewPolygon(testSquare(0, 0, 10), testSquare(3, 3, 2))
+	if p1.Equals(p2) {
+		t.Error("polygon, polygons with different holes should not be equal")
+	}
+
+	p2 = NewPolygon(testSquare(0, 0, 11), testSquare(2, 2, 2))
+	if p1.Equals(p2) {
+		t.Error("polygon, polygons with different outer rings should not be equal")
+	}
+}
+
+func TestPolygonClone(t *testing.T) {
+	p1 := NewPolygon(testSquare(0, 0, 10), testSquare(2, 2, 2))
+	p2 := p1.Clone()
+
+	if !p1.Equals(p2) {
+		t.Error("polygon, clone should be equal")
+	}
+
+	p2.Outer().SetAt(0, NewPoint(-1, -1))
+	p2.Holes()[0].SetAt(0, NewPoint(-1, -1))
+	if p1.Equals(p2) {
+		t.Error("polygon, clone should be a deep copy")
+	}
+}
+
+func TestPolygonValidate(t *testing.T) {
+	hole := testSquare(2, 2, 2)
+	hole.points[1], hole.points[3] = hole.points[3], hole.points[1]
+
+	p := NewPolygon(testSquare(0, 0, 10), hole)
+	if errs := p.Validate(); len(errs) != 0 {
+		t.Errorf("polygon, validate expected no errors, got %v", errs)
+	}
+
+	// unclosed, wrong winding
+	outer := testSquare(0, 0, 10)
+	outer.Pop()
+	outer.points[1], outer.points[3] = outer.points[3], outer.points[1]
+
+	if errs := NewPolygon(outer).Validate(); len(errs) != 2 {
+		t.Errorf("polygon, validate expected 2 errors, got %v", errs)
+	}
+
+	// hole wound the wrong way
+	if errs := NewPolygon(testSquare(0, 0, 10), testSquare(2, 2, 2)).Validate(); len(errs) != 1 {
+		t.Errorf("polygon, validate expected 1 error, got %v", errs)
+	}
+
+	// duplicate points
+	outer = testSquare(0, 0, 10)
+	outer.InsertAt(1, NewPoint(0, 0))
+	if errs := NewPolygon(outer).Validate(); len(errs) != 1 {
+		t.Errorf("polygon, validate expected 1 error, got %v", errs)
+	}
+
+	// bowtie
+	outer = NewPath()
+	outer.Push(NewPoint(0, 0))
+	outer.Push(NewPoint(2, 2))
+	outer.Push(NewPoint(2, 0))
+	outer.Push(NewPoint(0, 2))
+	outer.Push(NewPoint(0, 0))
+
+	errs := NewPolygon(outer).Validate()
+	found := false
+	for _, err := range errs {
+		if strings.Contains(err.Error(), "self-intersects") {
+			found = true
+		}
+	}
+
+	if !found {
+		t.Errorf("polygon, validate expected self-intersection error, got %v", errs)
+	}
+
+	// too few points
+	outer = NewPath()
+	outer.Push(NewPoint(0, 0))
+	outer.Push(NewPoint(2, 2))
+	outer.Push(NewPoint(0, 0))
+	if errs := NewPolygon(outer).Validate(); len(errs) != 1 {
+		t.Errorf("polygon, validate expected 1 error, got %v", errs)
+	}
+}
+
+func TestPolygonIsValid(t *testing.T) {
+	bowtie := NewPath()
+	bowtie.Push(NewPoint(0, 0))
+	bowtie.Push(NewPoint(1, 1))
+	bowtie.Push(NewPoint(1, 0))
+	bowtie.Push(NewPoint(0, 1))
+	bowtie.Push(NewPoint(0, 0))
+
+	open := testSquare(0, 0, 10)
+	open.Pop()
+
+	line := NewPath()
+	line.Push(NewPoint(0, 0))
+	line.Push(NewPoint(1, 1))
+	line.Push(NewPoint(0, 0))
+
+	// clockwise is not a problem
+	clockwise := testSquare(0, 0, 10)
+	clockwise.points[1], clockwise.points[3] = clockwise.points[3], clockwise.points[1]
+
+	type testCase struct {
+		polygon *Polygon
+		valid   bool
+		reason  string
+	}
+
+	tests := []testCase{
+		{NewPolygon(testSquare(0, 0, 10), testSquare(2, 2, 2), testSquare(5, 5, 2)), true, ""},
+		{NewPolygon(clockwise), true, ""},
+		{NewPolygon(open), false, "ring 0 is not closed"},
+		{NewPolygon(line), false, "ring 0 has too few points"},
+		{NewPolygon(bowtie), false, "ring 0 self-intersects"},
+		{NewPolygon(testSquare(0, 0, 10), testSquare(8, 8, 4)), false, "ring 1 intersects the outer ring"},
+		{NewPolygon(testSquare(0, 0, 10), testSquare(20, 20, 2)), false, "ring 1 is outside the outer ring"},
+		{NewPolygon(testSquare(0, 0, 10), testSquare(2, 2, 4), testSquare(3, 3, 4)), false, "ring 2 intersects ring 1"},
+		{NewPolygon(testSquare(0, 0, 10), testSquare(2, 2, 6), testSquare(3, 3, 1)), false, "ring 1 and ring 2 are nested"},
+	}
+
+	for i, test := range tests {
+		valid, reason := test.polygon.IsValid()
+		if valid != test.valid || reason != test.reason {
+			t.Errorf("polygon, isValid %d expected %v %q, got %v %q", i, test.valid, test.reason, valid, reason)
+		}
+	}
+}
+
+func TestPolygonRepair(t *testing.T) {
+	outer := testSquare(0, 0, 10)
+	outer.Pop()
+	outer.InsertAt(1, NewPoint(0, 0))
+	outer.points[2], outer.points[4] = outer.points[4], outer.points[2]
+
+	p := NewPolygon(outer, testSquare(2, 2, 2))
+	if errs := p.Validate(); len(errs) == 0 {
+		t.Fatal("polygon, repair test polygon should be invalid")
+	}
+
+	p.Repair()
+	if errs := p.Validate(); len(errs) != 0 {
+		t.Errorf("polygon, repair should fix the polygon, got %v", errs)
+	}
+
+	if l := p.Outer().Length(); l != 5 {
+		t.Errorf("polygon, repair expected 5 points in outer ring, got %d", l)
+	}
+
+	if b := p.Bound(); !b.Equals(NewBound(0, 10, 0, 10)) {
+		t.Errorf("polygon, repair should not change the shape, got %v", b)
+	}
+}
+
+func TestPolygonFromRings(t *testing.T) {
+	hole := testSquare(2, 2, 2)
+	outside := testSquare(20, 20, 2)
+
+	// clockwise, so wound the wrong way
+	outer := NewPath()
+	outer.Push(NewPoint(0, 0))
+	outer.Push(NewPoint(0, 10))
+	outer.Push(NewPoint(10, 10))
+	outer.Push(NewPoint(10, 0))
+	outer.Push(NewPoint(0, 0))
+
+	p := PolygonFromRings([]*Path{hole, outer, outside})
+	if b := p.Bound(); !b.Equals(NewBound(0, 10, 0, 10)) {
+		t.Errorf("polygon, fromRings expected largest ring as outer, got %v", b)
+	}
+
+	if l := len(p.Holes()); l != 1 {
+		t.Fatalf("polygon, fromRings expected 1 hole, got %d", l)
+	}
+
+	if b := p.Holes()[0].Bound(); !b.Equals(NewBound(2, 4, 2, 4)) {
+		t.Errorf("polygon, fromRings expected inner ring as hole, got %v", b)
+	}
+
+	if errs := p.Validate(); len(errs) != 0 {
+		t.Errorf("polygon, fromRings should fix the winding, got %v", errs)
+	}
+
+	if p.Outer() == outer {
+		t.Error("polygon, fromRings should copy the rings")
+	}
+
+	if p := PolygonFromRings(nil); p != nil {
+		t.Errorf("polygon, fromRings with no rings should be nil, got %v", p)
+	}
+}
+
+func TestPolygonIntersection(t *testing.T) {
+	// overlapping squares
+	p1 := NewPolygon(testSquare(0, 0, 4))
+	p2 := NewPolygon(testSquare(2, 2, 4))
+
+	result := p1.Intersection(p2)
+	if len(result) != 1 {
+		t.Fatalf("polygon, intersection expected 1 polygon, got %d", len(result))
+	}
+
+	expected := NewBound(2, 4, 2, 4)
+	if b := result[0].Bound(); !b.Equals(expected) {
+		t.Errorf("polygon, intersection expected %v, got %v", expected, b)
+	}
+
+	if l := result[0].Outer().Length(); l != 5 {
+		t.Errorf("polygon, intersection expected closed ring of 5 points, got %d", l)
+	}
+
+	if b := p2.Intersection(p1)[0].Bound(); !b.Equals(expected) {
+		t.Errorf("polygon, intersection expected %v, got %v", expected, b)
+	}
+
+	// disjoint
+	p2 = NewPolygon(testSquare(10, 10, 1))
+	if result := p1.Intersection(p2); len(result) != 0 {
+		t.Errorf("polygon, intersection of disjoint polygons should be empty, got %v", result)
+	}
+
+	// contained
+	p2 = NewPolygon(testSquare(1, 1, 1))
+	if result := p1.Intersection(p2); len(result) != 1 || !result[0].Equals(p2) {
+		t.Errorf("polygon, intersection should be the contained polygon, got %v", result)
+	}
+
+	if result := p2.Intersection(p1); len(result) != 1 || !result[0].Equals(p2) {
+		t.Errorf("polygon, intersection should be the contained polygon, got %v", result)
+	}
+
+	// u shape crossed by a bar results in two pieces
+	u := NewPath()
+	u.Push(NewPoint(0, 0))
+	u.Push(NewPoint(5, 0))
+	u.Push(NewPoint(5, 5))
+	u.Push(NewPoint(4, 5))
+	u.Push(NewPoint(4, 1))
+	u.Push(NewPoint(1, 1))
+	u.Push(NewPoint(1, 5))
+	u.Push(NewPoint(0, 5))
+	u.Push(NewPoint(0, 0))
+
+	bar := NewPath()
+	bar.Push(NewPoint(-1, 3))
+	bar.Push(NewPoint(6, 3))
+	bar.Push(NewPoint(6, 4))
+	bar.Push(NewPoint(-1, 4))
+	bar.Push(NewPoint(-1, 3))
+
+	result = NewPolygon(u).Intersection(NewPolygon(bar))
+	if len(result) != 2 {
+		t.Fatalf("polygon, intersection expected 2 polygons, got %d", len(result))
+	}
+
+	left := NewBound(0, 1, 3, 4)
+	right := NewBound(4, 5, 3, 4)
+	b1, b2 := result[0].Bound(), result[1].Bound()
+	if !(b1.Equals(left) && b2.Equals(right)) && !(b1.Equals(right) && b2.Equals(left)) {
+		t.Errorf("polygon, intersection pieces incorrect, got %v and %v", b1, b2)
+	}
+}
@@ -0,0 +1,39 @@
+package geo
+
+import "sync"
+
+// A PointPool is a sync.Pool backed allocator of points.
+// Useful to reduce garbage collection pressure in hot paths
+// that need many short lived temporary points.
+type PointPool struct {
+	pool sync.Pool
+}
+
+// NewPointPool creates a new, empty, point pool.
+func NewPointPool() *PointPool {
+	return &PointPool{
+		pool: sync.Pool{
+			New: func() interface{} {
+				return &Point{}
+			},
+		},
+	}
+}
+
+// Get returns a zeroed point from the pool, allocating a new one if necessary.
+func (pp *PointPool) Get() *Point {
+	p := pp.pool.Get().(*Point)
+	p[0], p[1] = 0, 0
+
+	return p
+}
+
+// Put returns the point to the pool. The point must not be used after
+// it is put back as it may be returned by a later call to Get.
+func (pp *PointPool) Put(p *Point) {
+	if p == nil || p == InfinityPoint {
+		return
+	}
+
+	pp.pool.Put(p)
+}
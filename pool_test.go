@@ -0,0 +1,28 @@
+package geo
+
+import "testing"
+
+func TestPointPool(t *testing.T) {
+	pool := NewPointPool()
+
+	p := pool.Get()
+	if !p.Equals(NewPoint(0, 0)) {
+		t.Errorf("pool, get should return zero point, got %v", p)
+	}
+
+	p.SetX(1).SetY(2)
+	pool.Put(p)
+
+	p = pool.Get()
+	if !p.Equals(NewPoint(0, 0)) {
+		t.Errorf("pool, get should return zeroed point, got %v", p)
+	}
+
+	// should not panic or be reused
+	pool.Put(nil)
+	pool.Put(InfinityPoint)
+
+	if p := pool.Get(); p == InfinityPoint {
+		t.Error("pool, should not reuse the infinity point")
+	}
+}
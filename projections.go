@@ -14,8 +14,28 @@ type Projection struct {
 	Inverse Projector
 }
 
+// ProjectSlice projects all the points in place using the projection,
+// for points not in a Path. The results match Path.Transform.
+func ProjectSlice(points []Point, projection Projection) {
+	for i := range points {
+		projection.Project(&points[i])
+	}
+}
+
+// InverseSlice inverse projects all the points in place using the projection,
+// for points not in a Path. The results match Path.Transform.
+func InverseSlice(points []Point, projection Projection) {
+	for i := range points {
+		projection.Inverse(&points[i])
+	}
+}
+
 const mercatorPole = 20037508.34
 
+// mercatorMaxLatitude is the latitude at which Web Mercator becomes a square,
+// latitudes beyond this are clamped by ScalarMercator.
+const mercatorMaxLatitude = 85.0511287798066
+
 // Mercator projection, performs EPSG:3857, sometimes also described as EPSG:900913.
 var Mercator = Projection{
 	Project: func(p *Point) {
@@ -75,6 +95,68 @@ func BuildTransverseMercator(centerLng float64) Projection {
 	}
 }
 
+// BuildAzimuthalEquidistant builds an azimuthal equidistant projection centered on the
+// given lng/lat point. The center projects to the origin and the distance, in meters, and
+// direction from the center to any point are preserved. Useful for "distance from here" maps.
+// Uses a spherical earth of radius EarthRadius. The center is copied, so changing it
+// afterwards does not affect the projection.
+// http://en.wikipedia.org/wiki/Azimuthal_equidistant_projection
+func BuildAzimuthalEquidistant(center *Point) Projection {
+	center = center.Clone()
+
+	centerLat := deg2rad(center.Lat())
+	centerLng := deg2rad(center.Lng())
+
+	sinCenterLat := math.Sin(centerLat)
+	cosCenterLat := math.Cos(centerLat)
+
+	return Projection{
+		Project: func(p *Point) {
+			lat := deg2rad(p.Lat())
+			dLng := deg2rad(p.Lng()) - centerLng
+
+			cosC := sinCenterLat*math.Sin(lat) + cosCenterLat*math.Cos(lat)*math.Cos(dLng)
+			c := math.Acos(math.Max(-1, math.Min(1, cosC)))
+
+			k := 1.0
+			if c != 0 {
+				k = c / math.Sin(c)
+			}
+
+			p.SetX(EarthRadius * k * math.Cos(lat) * math.Sin(dLng))
+			p.SetY(EarthRadius * k * (cosCenterLat*math.Sin(lat) - sinCenterLat*math.Cos(lat)*math.Cos(dLng)))
+		},
+		Inverse: func(p *Point) {
+			x := p.X()
+			y := p.Y()
+
+			rho := math.Sqrt(x*x + y*y)
+			if rho == 0 {
+				p.SetLng(center.Lng())
+				p.SetLat(center.Lat())
+				return
+			}
+
+			c := rho / EarthRadius
+			sinC := math.Sin(c)
+			cosC := math.Cos(c)
+
+			lat := math.Asin(math.Max(-1, math.Min(1, cosC*sinCenterLat+y*sinC*cosCenterLat/rho)))
+			lng := centerLng + math.Atan2(x*sinC, rho*cosCenterLat*cosC-y*sinCenterLat*sinC)
+
+			lng = rad2deg(lng)
+			if lng > 180 {
+				lng -= 360
+			} else if lng < -180 {
+				lng += 360
+			}
+
+			p.SetLng(lng)
+			p.SetLat(rad2deg(lat))
+		},
+	}
+}
+
 // TransverseMercator implements a default transverse Mercator projector
 // that will only work well +-10 degrees around longitude 0.
 var TransverseMercator = Projection{
@@ -101,6 +183,7 @@ var TransverseMercator = Projection{
 
 // ScalarMercator converts from lng/lat float64 to x,y uint64.
 // This is similar to Google's world coordinates.
+// Latitudes are clamped to the Web Mercator limit of about +-85.0511 degrees.
 var ScalarMercator struct {
 	Level   uint64
 	Project func(lng, lat float64) (x, y uint64)
@@ -128,17 +211,17 @@ func scalarMercatorProject(lng, lat float64, level uint64) (x, y uint64) {
 	lng = lng/360.0 + 0.5
 	x = (uint64)(lng * maxtiles)
 
-	// bound it because we have a top of the world problem
+	// bound it because we have a top of the world problem,
+	// clamp to the limits of Web Mercator like online maps do.
+	lat = math.Max(-mercatorMaxLatitude, math.Min(lat, mercatorMaxLatitude))
 	siny := math.Sin(lat * math.Pi / 180.0)
 
-	if siny < -0.9999 {
-		lat = 0.5 + 0.5*math.Log((1.0+siny)/(1.0-siny))/(-2*math.Pi)
+	lat = 0.5 + 0.5*math.Log((1.0+siny)/(1.0-siny))/(-2*math.Pi)
+	if lat <= 0 {
 		y = 0
-	} else if siny > 0.9999 {
-		lat = 0.5 + 0.5*math.Log((1.0+siny)/(1.0-siny))/(-2*math.Pi)
+	} else if lat >= 1 {
 		y = factor - 1
 	} else {
-		lat = 0.5 + 0.5*math.Log((1.0+siny)/(1.0-siny))/(-2*math.Pi)
 		y = (uint64)(lat * maxtiles)
 	}
 
@@ -44,6 +44,30 @@ func TestMercator(t *testing.T) {
 	}
 }
 
+func TestProjectSlice(t *testing.T) {
+	path := NewPath()
+	for _, city := range cities {
+		path.Push(NewPoint(city[1], city[0]))
+	}
+
+	for _, projection := range []Projection{Mercator, TransverseMercator} {
+		points := path.Clone().Points()
+		ProjectSlice(points, projection)
+
+		expected := path.Clone().Transform(projection.Project)
+		if !NewPath().SetPoints(points).Equals(expected) {
+			t.Errorf("projectSlice, should match transform")
+		}
+
+		InverseSlice(points, projection)
+
+		expected.Transform(projection.Inverse)
+		if !NewPath().SetPoints(points).Equals(expected) {
+			t.Errorf("inverseSlice, should match transform")
+		}
+	}
+}
+
 func TestMercatorScaleFactor(t *testing.T) {
 	expected := 1.154701
 	if f := MercatorScaleFactor(30.0); math.Abs(expected-f) > epsilon {
@@ -201,6 +225,61 @@ func TestBuildTransverseMercator(t *testing.T) {
 	}
 }
 
+func TestBuildAzimuthalEquidistant(t *testing.T) {
+	center := NewPoint(-122.4167, 37.7833)
+	projection := BuildAzimuthalEquidistant(center)
+
+	p := center.Clone()
+	projection.Project(p)
+	if p.X() != 0 || p.Y() != 0 {
+		t.Errorf("AzimuthalEquidistant, center should project to origin, got %v", p)
+	}
+
+	projection.Inverse(p)
+	if !p.Equals(center) {
+		t.Errorf("AzimuthalEquidistant, origin should inverse to center, got %v", p)
+	}
+
+	for _, city := range cities {
+		p := NewPoint(city[1], city[0])
+
+		// distance from the center is preserved
+		expected := center.GeoDistanceFrom(p, true)
+		projection.Project(p)
+
+		if d := p.DistanceFrom(NewPoint(0, 0)); math.Abs(d-expected) > 1 {
+			t.Errorf("AzimuthalEquidistant, distance miss match: %f != %f", d, expected)
+		}
+
+		projection.Inverse(p)
+
+		if math.Abs(p.Lat()-city[0]) > epsilon {
+			t.Errorf("AzimuthalEquidistant, latitude miss match: %f != %f", p.Lat(), city[0])
+		}
+
+		if math.Abs(p.Lng()-city[1]) > epsilon {
+			t.Errorf("AzimuthalEquidistant, longitude miss match: %f != %f", p.Lng(), city[1])
+		}
+	}
+
+	// due north is straight up
+	p = NewPoint(-122.4167, 40)
+	projection.Project(p)
+	if math.Abs(p.X()) > epsilon || p.Y() <= 0 {
+		t.Errorf("AzimuthalEquidistant, north should be up, got %v", p)
+	}
+
+	// changing the center afterwards should not affect the projection
+	original := center.Clone()
+	center.SetLng(0).SetLat(0)
+
+	p = NewPoint(0, 0)
+	projection.Inverse(p)
+	if !p.Equals(original) {
+		t.Errorf("AzimuthalEquidistant, should copy the center, got %v", p)
+	}
+}
+
 func TestScalarMercator(t *testing.T) {
 
 	x, y := ScalarMercator.Project(0, 0)
@@ -248,12 +327,20 @@ func TestScalarMercator(t *testing.T) {
 		}
 	}
 
-	// test polar regions
-	if _, y := ScalarMercator.Project(0, 89.9); y != (1<<ScalarMercator.Level)-1 {
-		t.Errorf("Scalar Mercator, top of the world error, got %d", y)
+	// test polar regions, clamped to the Web Mercator limits
+	for _, lat := range []float64{85.06, 89, 89.9, 90} {
+		if _, y := ScalarMercator.Project(0, lat); y != 0 {
+			t.Errorf("Scalar Mercator, top of the world error for %f, got %d", lat, y)
+		}
+	}
+
+	for _, lat := range []float64{-85.06, -89, -89.9, -90} {
+		if _, y := ScalarMercator.Project(0, lat); y != (1<<ScalarMercator.Level)-1 {
+			t.Errorf("Scalar Mercator, bottom of the world error for %f, got %d", lat, y)
+		}
 	}
 
-	if _, y := ScalarMercator.Project(0, -89.9); y != 0 {
-		t.Errorf("Scalar Mercator, bottom of the world error, got %d", y)
+	if _, y := ScalarMercator.Project(0, 85); y == 0 {
+		t.Error("Scalar Mercator, latitude within limits should not be clamped")
 	}
 }
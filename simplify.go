@@ -0,0 +1,325 @@
+package geo
+
+import "math"
+
+// SimplifyDouglasPeucker simplifies the points using the Douglas-Peucker method.
+// The first and last points are always kept. Returns a new slice with pointers
+// to the kept points, the points themselves are not copied.
+// See http://en.wikipedia.org/wiki/Ramer%E2%80%93Douglas%E2%80%93Peucker_algorithm
+func SimplifyDouglasPeucker(points []*Point, threshold float64) []*Point {
+	if len(points) <= 2 {
+		return append([]*Point(nil), points...)
+	}
+
+	values := make([]Point, len(points))
+	for i, p := range points {
+		values[i] = *p
+	}
+
+	mask := make([]bool, len(points))
+	mask[0] = true
+	mask[len(points)-1] = true
+
+	found := workerReduce(values, threshold, mask)
+
+	result := make([]*Point, 0, found+2)
+	for i, v := range mask {
+		if v {
+			result = append(result, points[i])
+		}
+	}
+
+	return result
+}
+
+// Reduce simplifies the path using the Douglas-Peucker method
+// with the threshold in the units of the points.
+// Returns a new path and DOES NOT modify the original.
+func (p *Path) Reduce(threshold float64) *Path {
+	if len(p.points) <= 2 {
+		return p.Clone()
+	}
+
+	mask := make([]bool, len(p.points))
+	mask[0] = true
+	mask[len(p.points)-1] = true
+
+	found := workerReduce(p.points, threshold, mask)
+
+	result := NewPathPreallocate(0, found+2)
+	for i, v := range mask {
+		if v {
+			result.points = append(result.points, p.points[i])
+		}
+	}
+
+	return result
+}
+
+// ReduceWithIndices simplifies the path using the Douglas-Peucker method,
+// the same as Reduce, but also returns the indexes of the kept points in the original path.
+// The indexes are in ascending order and include 0 and Length-1.
+// Returns a new path and DOES NOT modify the original.
+func (p *Path) ReduceWithIndices(threshold float64) (*Path, []int) {
+	if len(p.points) <= 2 {
+		indexes := make([]int, len(p.points))
+		for i := range indexes {
+			indexes[i] = i
+		}
+
+		return p.Clone(), indexes
+	}
+
+	mask := make([]bool, len(p.points))
+	mask[0] = true
+	mask[len(p.points)-1] = true
+
+	found := workerReduce(p.points, threshold, mask)
+
+	result := NewPathPreallocate(0, found+2)
+	indexes := make([]int, 0, found+2)
+	for i, v := range mask {
+		if v {
+			result.points = append(result.points, p.points[i])
+			indexes = append(indexes, i)
+		}
+	}
+
+	return result, indexes
+}
+
+// ReduceProjected simplifies the path using the Douglas-Peucker method, like Reduce,
+// but measures the distances after projecting the points with the given projector.
+// For example, with Mercator.Project the threshold is applied in meters instead of
+// distorted lng/lat degrees. The kept points are copied from the original path,
+// so no inverse projection is needed and there is no round off.
+// Returns a new path and DOES NOT modify the original.
+func (p *Path) ReduceProjected(project Projector, threshold float64) *Path {
+	_, indexes := p.Clone().Transform(project).ReduceWithIndices(threshold)
+
+	result := NewPathPreallocate(len(indexes), len(indexes))
+	for i, index := range indexes {
+		result.points[i] = p.points[index]
+	}
+
+	return result
+}
+
+// ReduceWithAngle simplifies the path using the Douglas-Peucker method, like Reduce,
+// but always keeps vertices whose interior angle, in radians, is less than minAngle.
+// This preserves sharp corners, such as hairpin turns, that are small compared to the threshold.
+// A straight line has an interior angle of Pi and a complete reversal of 0.
+// The kept sharp vertices act as endpoints when simplifying the sections between them.
+// Returns a new path and DOES NOT modify the original.
+func (p *Path) ReduceWithAngle(threshold, minAngle float64) *Path {
+	points := p.points
+	if len(points) <= 2 {
+		return p.Clone()
+	}
+
+	mask := make([]bool, len(points))
+	mask[0] = true
+	mask[len(points)-1] = true
+
+	start := 0
+	for i := 1; i < len(points); i++ {
+		if i != len(points)-1 && interiorAngle(&points[i-1], &points[i], &points[i+1]) >= minAngle {
+			continue
+		}
+
+		mask[i] = true
+		workerReduce(points[start:i+1], threshold, mask[start:i+1])
+		start = i
+	}
+
+	result := NewPathPreallocate(0, len(points))
+	for i, v := range mask {
+		if v {
+			result.points = append(result.points, p.points[i])
+		}
+	}
+
+	return result
+}
+
+// SimplifyShared simplifies the paths using the Douglas-Peucker method, like Reduce,
+// but keeps boundaries shared between paths coincident, such as the common border of
+// two neighboring polygon rings. The paths are split into arcs at the vertices where the
+// set of paths sharing the adjacent edges changes, and at every path endpoint. The endpoints
+// of the arcs are always kept and each shared arc is simplified the same way in every path,
+// independent of direction. Points are considered shared only if they are exactly equal.
+// Returns new paths and DOES NOT modify the originals.
+func SimplifyShared(paths []*Path, threshold float64) []*Path {
+	edges := make(map[[2]Point][]int)
+	endpoints := make(map[Point]bool)
+
+	for k, p := range paths {
+		if len(p.points) == 0 {
+			continue
+		}
+
+		endpoints[p.points[0]] = true
+		endpoints[p.points[len(p.points)-1]] = true
+
+		for i := 1; i < len(p.points); i++ {
+			key := sharedEdgeKey(&p.points[i-1], &p.points[i])
+			if s := edges[key]; len(s) == 0 || s[len(s)-1] != k {
+				edges[key] = append(s, k)
+			}
+		}
+	}
+
+	result := make([]*Path, len(paths))
+	for k, p := range paths {
+		if len(p.points) <= 2 {
+			result[k] = p.Clone()
+			continue
+		}
+
+		points := p.points
+
+		mask := make([]bool, len(points))
+		mask[0] = true
+
+		start := 0
+		for i := 1; i < len(points); i++ {
+			if i != len(points)-1 && !endpoints[points[i]] {
+				before := edges[sharedEdgeKey(&points[i-1], &points[i])]
+				after := edges[sharedEdgeKey(&points[i], &points[i+1])]
+				if equalInts(before, after) {
+					continue
+				}
+			}
+
+			mask[i] = true
+			reduceArc(points[start:i+1], threshold, mask[start:i+1])
+			start = i
+		}
+
+		result[k] = NewPathPreallocate(0, len(points))
+		for i, v := range mask {
+			if v {
+				result[k].points = append(result[k].points, p.points[i])
+			}
+		}
+	}
+
+	return result
+}
+
+// reduceArc runs workerReduce on the points in a canonical direction, so that
+// an arc and its reverse are reduced to the same points.
+func reduceArc(points []Point, threshold float64, mask []bool) {
+	reversed := false
+	for i, j := 0, len(points)-1; i < j; i, j = i+1, j-1 {
+		if c := points[i].Compare(&points[j]); c != 0 {
+			reversed = c > 0
+			break
+		}
+	}
+
+	if !reversed {
+		workerReduce(points, threshold, mask)
+		return
+	}
+
+	n := len(points)
+	rPoints := make([]Point, n)
+	rMask := make([]bool, n)
+	for i := range points {
+		rPoints[n-1-i] = points[i]
+		rMask[n-1-i] = mask[i]
+	}
+
+	workerReduce(rPoints, threshold, rMask)
+	for i := range mask {
+		mask[i] = rMask[n-1-i]
+	}
+}
+
+// sharedEdgeKey returns the key of the segment independent of direction.
+func sharedEdgeKey(a, b *Point) [2]Point {
+	if a.Compare(b) > 0 {
+		return [2]Point{*b, *a}
+	}
+
+	return [2]Point{*a, *b}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// interiorAngle returns the angle, in radians [0, Pi], at b between the segments to a and c.
+// Returns Pi, i.e. straight, if either segment has zero length.
+func interiorAngle(a, b, c *Point) float64 {
+	ux, uy := a[0]-b[0], a[1]-b[1]
+	vx, vy := c[0]-b[0], c[1]-b[1]
+
+	if (ux == 0 && uy == 0) || (vx == 0 && vy == 0) {
+		return math.Pi
+	}
+
+	return math.Abs(math.Atan2(ux*vy-uy*vx, ux*vx+uy*vy))
+}
+
+// workerReduce does the Douglas-Peucker threshold checks, marking the points to keep
+// in the mask. Returns the number of points marked, not including the endpoints.
+// A stack is used instead of recursion for performance.
+func workerReduce(points []Point, threshold float64, mask []bool) int {
+	// nothing between the endpoints, and start == end for a single point
+	if len(points) <= 2 {
+		for i := range points {
+			mask[i] = true
+		}
+
+		return 0
+	}
+
+	found := 0
+
+	var stack []int
+	stack = append(stack, 0, len(points)-1)
+
+	l := &Line{}
+	for len(stack) > 0 {
+		start := stack[len(stack)-2]
+		end := stack[len(stack)-1]
+
+		l.a = points[start]
+		l.b = points[end]
+
+		maxDist := 0.0
+		maxIndex := 0
+		for i := start + 1; i < end; i++ {
+			dist := l.SquaredDistanceFrom(&points[i])
+
+			if dist > maxDist {
+				maxDist = dist
+				maxIndex = i
+			}
+		}
+
+		if maxDist > threshold*threshold {
+			found++
+			mask[maxIndex] = true
+
+			stack[len(stack)-1] = maxIndex
+			stack = append(stack, maxIndex, end)
+		} else {
+			stack = stack[:len(stack)-2]
+		}
+	}
+
+	return found
+}
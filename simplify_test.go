@@ -0,0 +1,324 @@
+package geo
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSimplifyDouglasPeucker(t *testing.T) {
+	points := []*Point{}
+	if reduced := SimplifyDouglasPeucker(points, 0.1); len(reduced) != 0 {
+		t.Errorf("simplify, expected empty result, got %v", reduced)
+	}
+
+	points = append(points, NewPoint(0, 0), NewPoint(0.5, 0.2))
+	if reduced := SimplifyDouglasPeucker(points, 1); len(reduced) != 2 {
+		t.Errorf("simplify, expected both points kept, got %v", reduced)
+	}
+
+	points = append(points, NewPoint(1, 0))
+	if reduced := SimplifyDouglasPeucker(points, 0.1); len(reduced) != 3 {
+		t.Errorf("simplify, expected 3 points, got %d", len(reduced))
+	}
+
+	reduced := SimplifyDouglasPeucker(points, 0.3)
+	if len(reduced) != 2 {
+		t.Fatalf("simplify, expected 2 points, got %d", len(reduced))
+	}
+
+	if reduced[0] != points[0] || reduced[1] != points[2] {
+		t.Errorf("simplify, expected the endpoints to be kept, got %v", reduced)
+	}
+
+	// collinear points are removed
+	points = []*Point{NewPoint(0, 0), NewPoint(0, 1), NewPoint(0, 2)}
+	if reduced := SimplifyDouglasPeucker(points, 0); len(reduced) != 2 {
+		t.Errorf("simplify, expected collinear points removed, got %v", reduced)
+	}
+}
+
+func TestPathReduce(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(1, 0.05))
+	p.Push(NewPoint(2, 0))
+	p.Push(NewPoint(3, 5))
+	p.Push(NewPoint(4, 0))
+
+	reduced := p.Reduce(0.1)
+
+	answer := NewPath()
+	answer.Push(NewPoint(0, 0))
+	answer.Push(NewPoint(2, 0))
+	answer.Push(NewPoint(3, 5))
+	answer.Push(NewPoint(4, 0))
+
+	if !reduced.Equals(answer) {
+		t.Errorf("path, reduce expected %v, got %v", answer.Points(), reduced.Points())
+	}
+
+	if p.Length() != 5 {
+		t.Error("path, reduce should not modify the original")
+	}
+
+	reduced.SetAt(0, NewPoint(10, 10))
+	if !p.GetAt(0).Equals(NewPoint(0, 0)) {
+		t.Error("path, reduce should return a copy")
+	}
+}
+
+func TestPathReduceWithIndices(t *testing.T) {
+	p := NewPath()
+
+	reduced, indexes := p.ReduceWithIndices(0.1)
+	if reduced.Length() != 0 || len(indexes) != 0 {
+		t.Errorf("path, reduce with indices of empty path should be empty, got %v", indexes)
+	}
+
+	p.Push(NewPoint(0, 0))
+	reduced, indexes = p.ReduceWithIndices(0.1)
+	if reduced.Length() != 1 || len(indexes) != 1 || indexes[0] != 0 {
+		t.Errorf("path, reduce with indices of single point incorrect, got %v", indexes)
+	}
+
+	p.Push(NewPoint(1, 0.05))
+	p.Push(NewPoint(2, 0))
+	p.Push(NewPoint(3, 5))
+	p.Push(NewPoint(4, 0))
+
+	reduced, indexes = p.ReduceWithIndices(0.1)
+	if !reduced.Equals(p.Reduce(0.1)) {
+		t.Errorf("path, reduce with indices should match reduce, got %v", reduced.Points())
+	}
+
+	expected := []int{0, 2, 3, 4}
+	if !reflect.DeepEqual(indexes, expected) {
+		t.Errorf("path, reduce with indices expected %v, got %v", expected, indexes)
+	}
+
+	for i, v := range indexes {
+		if !reduced.GetAt(i).Equals(p.GetAt(v)) {
+			t.Errorf("path, reduce with indices index %d does not map to the original point", i)
+		}
+	}
+}
+
+func TestPathReduceShortPaths(t *testing.T) {
+	p := NewPath()
+	for i := 0; i < 3; i++ {
+		reduced := p.Reduce(0.1)
+		if !reduced.Equals(p) {
+			t.Errorf("path, reduce of length %d should be the same, got %v", i, reduced.Points())
+		}
+
+		reduced, indexes := p.ReduceWithIndices(0.1)
+		if !reduced.Equals(p) {
+			t.Errorf("path, reduce with indices of length %d should be the same, got %v", i, reduced.Points())
+		}
+
+		for j, v := range indexes {
+			if j != v {
+				t.Errorf("path, reduce with indices of length %d should keep all indexes, got %v", i, indexes)
+			}
+		}
+
+		p.Push(NewPoint(float64(i), float64(i*i)))
+	}
+
+	// duplicate start and end
+	p = NewPath()
+	p.Push(NewPoint(1, 1))
+	p.Push(NewPoint(1, 1))
+	if reduced := p.Reduce(0); !reduced.Equals(p) {
+		t.Errorf("path, reduce should keep both points, got %v", reduced.Points())
+	}
+}
+
+func TestWorkerReduce(t *testing.T) {
+	for i := 0; i < 3; i++ {
+		points := make([]Point, i)
+		for j := range points {
+			points[j] = Point{float64(j), 0}
+		}
+
+		mask := make([]bool, i)
+		if found := workerReduce(points, 0.1, mask); found != 0 {
+			t.Errorf("worker reduce of length %d should not find points, got %d", i, found)
+		}
+
+		for j, v := range mask {
+			if !v {
+				t.Errorf("worker reduce of length %d should keep point %d", i, j)
+			}
+		}
+	}
+
+	// closed ring, start == end
+	points := []Point{{0, 0}, {1, 0}, {1, 1}, {0, 0}}
+	mask := []bool{true, false, false, true}
+	if found := workerReduce(points, 0.1, mask); found != 2 {
+		t.Errorf("worker reduce of ring should find 2 points, got %d", found)
+	}
+}
+
+func TestPathReduceProjected(t *testing.T) {
+	// at high latitude a degree of longitude is much shorter than a degree of latitude
+	p := NewPath()
+	p.Push(NewPoint(0, 80))
+	p.Push(NewPoint(0.5, 80.01))
+	p.Push(NewPoint(1, 80))
+
+	offset := NewPoint(0.5, 80).GeoDistanceFrom(NewPoint(0.5, 80.01))
+
+	if l := p.Reduce(0.02).Length(); l != 2 {
+		t.Errorf("path, reduce in degrees expected 2 points, got %d", l)
+	}
+
+	// in mercator, meters are scaled by 1/cos(lat)
+	factor := MercatorScaleFactor(80)
+	if l := p.ReduceProjected(Mercator.Project, 0.5*offset*factor).Length(); l != 3 {
+		t.Errorf("path, reduce projected expected 3 points, got %d", l)
+	}
+
+	if l := p.ReduceProjected(Mercator.Project, 2*offset*factor).Length(); l != 2 {
+		t.Errorf("path, reduce projected expected 2 points, got %d", l)
+	}
+
+	// original coordinates are kept exactly
+	reduced := p.ReduceProjected(Mercator.Project, 0)
+	if !reduced.Equals(p) {
+		t.Errorf("path, reduce projected should keep the original points, got %v", reduced.Points())
+	}
+
+	if !p.GetAt(1).Equals(NewPoint(0.5, 80.01)) {
+		t.Error("path, reduce projected should not modify the original")
+	}
+}
+
+func TestPathReduceWithAngle(t *testing.T) {
+	// a road with a small switchback
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(10, 0))
+	p.Push(NewPoint(10.5, 0.2))
+	p.Push(NewPoint(10, 0.4))
+	p.Push(NewPoint(20, 0.4))
+
+	if l := p.Reduce(1).Length(); l != 2 {
+		t.Errorf("path, reduce expected to lose the switchback, got %d points", l)
+	}
+
+	answer := NewPath()
+	answer.Push(NewPoint(0, 0))
+	answer.Push(NewPoint(10.5, 0.2))
+	answer.Push(NewPoint(10, 0.4))
+	answer.Push(NewPoint(20, 0.4))
+
+	if reduced := p.ReduceWithAngle(1, math.Pi/2); !reduced.Equals(answer) {
+		t.Errorf("path, reduce with angle expected %v, got %v", answer.Points(), reduced.Points())
+	}
+
+	// no sharp angles is the same as reduce
+	if reduced := p.ReduceWithAngle(1, 0); !reduced.Equals(p.Reduce(1)) {
+		t.Errorf("path, reduce with angle expected same as reduce, got %v", reduced.Points())
+	}
+
+	// the threshold still applies between sharp vertices
+	p.InsertAt(1, NewPoint(5, 2))
+	if reduced := p.ReduceWithAngle(1, math.Pi/2); reduced.Length() != 5 {
+		t.Errorf("path, reduce with angle expected 5 points, got %v", reduced.Points())
+	}
+
+	if p.Length() != 6 {
+		t.Error("path, reduce with angle should not modify the original")
+	}
+
+	short := NewPath()
+	short.Push(NewPoint(0, 0))
+	if l := short.ReduceWithAngle(1, 1).Length(); l != 1 {
+		t.Errorf("path, reduce with angle of short path should be unchanged, got %d", l)
+	}
+}
+
+func TestSimplifyShared(t *testing.T) {
+	border := []*Point{
+		NewPoint(1, 0), NewPoint(1.01, 0.2), NewPoint(0.99, 0.4),
+		NewPoint(1.02, 0.6), NewPoint(0.995, 0.8), NewPoint(1, 1),
+	}
+
+	left := NewPath()
+	left.Push(NewPoint(0, 0))
+	for _, p := range border {
+		left.Push(p)
+	}
+	left.Push(NewPoint(0, 1))
+	left.Push(NewPoint(0, 0))
+
+	// goes along the border the other way and starts in the middle of it
+	right := NewPath()
+	for i := 3; i >= 0; i-- {
+		right.Push(border[i])
+	}
+	right.Push(NewPoint(2, 0))
+	right.Push(NewPoint(2, 1))
+	for i := 5; i >= 3; i-- {
+		right.Push(border[i])
+	}
+
+	result := SimplifyShared([]*Path{left, right}, 0.05)
+
+	sharedPoints := func(p *Path) map[Point]bool {
+		points := make(map[Point]bool)
+		for _, point := range p.Points() {
+			if point[0] > 0.9 && point[0] < 1.1 {
+				points[point] = true
+			}
+		}
+
+		return points
+	}
+
+	l, r := sharedPoints(result[0]), sharedPoints(result[1])
+	if !reflect.DeepEqual(l, r) {
+		t.Errorf("simplifyShared, border should be the same, got %v and %v", l, r)
+	}
+
+	expected := map[Point]bool{*border[0]: true, *border[3]: true, *border[5]: true}
+	if !reflect.DeepEqual(l, expected) {
+		t.Errorf("simplifyShared, expected border %v, got %v", expected, l)
+	}
+
+	if l := result[0].Length(); l != 6 {
+		t.Errorf("simplifyShared, expected 6 points, got %d", l)
+	}
+
+	// should not modify the originals
+	if left.Length() != 9 || right.Length() != 9 {
+		t.Errorf("simplifyShared, should not modify the paths, got %d %d", left.Length(), right.Length())
+	}
+
+	// short paths are copied
+	result = SimplifyShared([]*Path{NewPath(), NewPath().Push(NewPoint(1, 1))}, 1)
+	if result[0].Length() != 0 || result[1].Length() != 1 {
+		t.Errorf("simplifyShared, short paths should be unchanged, got %v", result)
+	}
+}
+
+func TestInteriorAngle(t *testing.T) {
+	if a := interiorAngle(NewPoint(0, 0), NewPoint(1, 0), NewPoint(2, 0)); math.Abs(a-math.Pi) > epsilon {
+		t.Errorf("simplify, straight angle expected pi, got %f", a)
+	}
+
+	if a := interiorAngle(NewPoint(0, 0), NewPoint(1, 0), NewPoint(1, -1)); math.Abs(a-math.Pi/2) > epsilon {
+		t.Errorf("simplify, right angle expected pi/2, got %f", a)
+	}
+
+	if a := interiorAngle(NewPoint(0, 0), NewPoint(1, 0), NewPoint(0, 0)); a != 0 {
+		t.Errorf("simplify, reversal expected 0, got %f", a)
+	}
+
+	if a := interiorAngle(NewPoint(0, 0), NewPoint(0, 0), NewPoint(1, 1)); a != math.Pi {
+		t.Errorf("simplify, zero length segment expected pi, got %f", a)
+	}
+}